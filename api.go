@@ -2,15 +2,16 @@ package ubot
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
-	"log"
 	"mime/multipart"
 	"net/http"
 	"net/textproto"
+	"net/url"
 	"strconv"
 	"strings"
 
@@ -26,10 +27,30 @@ func (e errEncoding) Error() string {
 }
 
 type apiResponse struct {
-	Ok          bool            `json:"ok"`
-	ErrorCode   int64           `json:"error_code,omitempty"`
-	Description string          `json:"description,omitempty"`
-	Result      json.RawMessage `json:"result"`
+	Ok          bool                `json:"ok"`
+	ErrorCode   int64               `json:"error_code,omitempty"`
+	Description string              `json:"description,omitempty"`
+	Parameters  *ResponseParameters `json:"parameters,omitempty"`
+	Result      json.RawMessage     `json:"result"`
+}
+
+// ResponseParameters describes why a request was unsuccessful.
+// see https://core.telegram.org/bots/api#responseparameters
+type ResponseParameters struct {
+	MigrateToChatID int64 `json:"migrate_to_chat_id,omitempty"`
+	RetryAfter      int64 `json:"retry_after,omitempty"`
+}
+
+// APIError is returned when the API server replies with an unsuccessful response.
+// Use errors.As to inspect the error code and the response parameters.
+type APIError struct {
+	ErrorCode   int64
+	Description string
+	Parameters  ResponseParameters
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("api error %d: %s", e.ErrorCode, e.Description)
 }
 
 func decodeJsonResponse(bytes []byte) (result interface{}, err error) {
@@ -40,10 +61,16 @@ func decodeJsonResponse(bytes []byte) (result interface{}, err error) {
 	}
 
 	if reply.Ok {
-		log.Println(string(reply.Result))
 		err = json.Unmarshal(reply.Result, &result)
 	} else {
-		err = errors.New(reply.Description)
+		apiErr := &APIError{
+			ErrorCode:   reply.ErrorCode,
+			Description: reply.Description,
+		}
+		if reply.Parameters != nil {
+			apiErr.Parameters = *reply.Parameters
+		}
+		err = apiErr
 	}
 	return
 }
@@ -51,102 +78,173 @@ func decodeJsonResponse(bytes []byte) (result interface{}, err error) {
 // ApiClient serves as a mocking wrapper for http.Client
 // there's no need to export this
 type apiClient interface {
-	GetBytes(URl string) (result []byte, err error)
-	PostBytes(URL string, data interface{}) (result []byte, err error)
-	GetJson(URL string) (result interface{}, err error)
-	PostJson(URL string, request interface{}) (result interface{}, err error)
-	PostMultipart(URL string, request axon.O) (result interface{}, err error)
+	GetBytes(ctx context.Context, URL string) (result []byte, err error)
+	PostBytes(ctx context.Context, URL string, data interface{}) (result []byte, err error)
+	GetJson(ctx context.Context, URL string) (result interface{}, err error)
+	PostJson(ctx context.Context, URL string, request interface{}) (result interface{}, err error)
+	PostMultipart(ctx context.Context, URL string, request axon.O) (result interface{}, err error)
 }
 
 type httpApiClient struct {
-	httpClient http.Client
+	httpClient  http.Client
+	retryPolicy RetryPolicy
+	logger      Logger
+	limiter     *rateLimiter
+	token       string
 }
 
-func (h *httpApiClient) GetBytes(URL string) (result []byte, err error) {
-	log.Println(URL)
-	resp, err := h.httpClient.Get(URL)
-	if err != nil {
-		log.Fatal(err)
+// log returns the client Logger, falling back to NopLogger
+func (h *httpApiClient) log() Logger {
+	if h.logger == nil {
+		return NopLogger{}
+	}
+	return h.logger
+}
+
+// statusError is returned when the API server replies with an HTTP error
+// status and a body that is not a Bot API response.
+type statusError struct {
+	StatusCode int
+	Status     string
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("bad status: %s", e.Status)
+}
+
+// readResponse reads the response body. Error statuses are reported as the APIError
+// carried by the body, or as a statusError if the body is not a Bot API error reply.
+func readResponse(resp *http.Response) (result []byte, err error) {
+	if result, err = ioutil.ReadAll(resp.Body); err != nil || resp.StatusCode == http.StatusOK {
 		return
 	}
-	defer resp.Body.Close()
+	var apiErr *APIError
+	if _, decodeErr := decodeJsonResponse(result); errors.As(decodeErr, &apiErr) {
+		err = apiErr
+	} else {
+		err = &statusError{StatusCode: resp.StatusCode, Status: resp.Status}
+	}
+	return
+}
 
-	if result, err = ioutil.ReadAll(resp.Body); err == nil {
-		log.Println(string(result))
+// redact removes the API token from the request URL carried by err
+func (h *httpApiClient) redact(err error) error {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		urlErr.URL = redactToken(urlErr.URL, h.token)
 	}
+	return err
+}
+
+func (h *httpApiClient) GetBytes(ctx context.Context, URL string) (result []byte, err error) {
+	var (
+		req  *http.Request
+		resp *http.Response
+	)
+	h.log().Log(LevelDebug, "get request", "url", URL)
+	if req, err = http.NewRequestWithContext(ctx, http.MethodGet, URL, nil); err != nil {
+		err = h.redact(err)
+		return
+	}
+	if resp, err = h.httpClient.Do(req); err != nil {
+		err = h.redact(err)
+		return
+	}
+	defer resp.Body.Close()
+
+	result, err = readResponse(resp)
 	return
 }
 
 // PostBytes perform a low level post requesto to the API server.
-func (h *httpApiClient) PostBytes(URL string, data interface{}) (result []byte, err error) {
+func (h *httpApiClient) PostBytes(ctx context.Context, URL string, data interface{}) (result []byte, err error) {
+	var buffer []byte
+	if buffer, err = json.Marshal(data); err != nil {
+		return
+	}
+	h.log().Log(LevelDebug, "post request", "url", URL, "body", string(buffer))
+	return h.post(ctx, URL, "application/json", buffer)
+}
+
+// post sends body to the API server with the given content type.
+func (h *httpApiClient) post(ctx context.Context, URL string, contentType string, body []byte) (result []byte, err error) {
 	var (
-		buffer []byte
-		resp   *http.Response
+		req  *http.Request
+		resp *http.Response
 	)
-	if buffer, err = json.Marshal(data); err != nil {
+	if req, err = http.NewRequestWithContext(ctx, http.MethodPost, URL, bytes.NewReader(body)); err != nil {
+		err = h.redact(err)
 		return
 	}
-	log.Println("posting request: ", string(buffer))
-	if resp, err = h.httpClient.Post(URL, "application/json", bytes.NewBuffer(buffer)); err != nil {
-		log.Fatal(err)
+	req.Header.Set("Content-Type", contentType)
+	if resp, err = h.httpClient.Do(req); err != nil {
+		err = h.redact(err)
 		return
 	}
 	defer resp.Body.Close()
 
-	if result, err = ioutil.ReadAll(resp.Body); err == nil {
-		log.Println("got response: ", string(result))
-	}
+	result, err = readResponse(resp)
 	return
 }
 
 // PostJson perform a get to the API server. Get JSON encoded response payload.
-func (h *httpApiClient) GetJson(URL string) (result interface{}, err error) {
-	var buffer []byte
-	if buffer, err = h.GetBytes(URL); err != nil {
+func (h *httpApiClient) GetJson(ctx context.Context, URL string) (result interface{}, err error) {
+	err = h.retryPolicy.do(ctx, h.log(), func() (err error) {
+		if err = h.limiter.wait(ctx, nil); err != nil {
+			return
+		}
+		var buffer []byte
+		if buffer, err = h.GetBytes(ctx, URL); err != nil {
+			return
+		}
+		h.log().Log(LevelDebug, "got response", "url", URL, "body", string(buffer))
+		result, err = decodeJsonResponse(buffer)
 		return
-	}
-	result, err = decodeJsonResponse(buffer)
+	})
 	return
 }
 
 // PostJson perform a JSON encoded post to the API server. Get JSON encoded response payload.
-func (h *httpApiClient) PostJson(URL string, request interface{}) (result interface{}, err error) {
-	var buffer []byte
-	if buffer, err = h.PostBytes(URL, request); err != nil {
+func (h *httpApiClient) PostJson(ctx context.Context, URL string, request interface{}) (result interface{}, err error) {
+	err = h.retryPolicy.do(ctx, h.log(), func() (err error) {
+		if err = h.limiter.wait(ctx, request); err != nil {
+			return
+		}
+		var buffer []byte
+		if buffer, err = h.PostBytes(ctx, URL, request); err != nil {
+			return
+		}
+		h.log().Log(LevelDebug, "got response", "url", URL, "body", string(buffer))
+		result, err = decodeJsonResponse(buffer)
 		return
-	}
-	result, err = decodeJsonResponse(buffer)
+	})
 	return
 }
 
 // PostMultipart posts a multipart encoded request body to the API server
 // Use this for sending files, photos, documents...
-func (h *httpApiClient) PostMultipart(URL string, request axon.O) (result interface{}, err error) {
+func (h *httpApiClient) PostMultipart(ctx context.Context, URL string, request axon.O) (result interface{}, err error) {
 	// Prepare a form that you will submit to that URL.
 	var contentType string
 	var buffer *bytes.Buffer
 	if contentType, buffer, err = prepareMultipart(request); err != nil {
 		return
 	}
-	log.Println(buffer.String())
+	h.log().Log(LevelDebug, "post multipart request", "url", URL, "size", buffer.Len())
 
-	// Submit the request
-	var resp *http.Response
-	if resp, err = http.Post(URL, contentType, buffer); err != nil {
-		return
-	}
-	defer resp.Body.Close()
-
-	// Check the response
-	var bytes []byte
-	if bytes, err = ioutil.ReadAll(resp.Body); err == nil {
-		log.Println(string(bytes))
-	}
-	if resp.StatusCode != http.StatusOK {
-		err = fmt.Errorf("bad status: %s", resp.Status)
-	} else {
+	body := buffer.Bytes()
+	err = h.retryPolicy.do(ctx, h.log(), func() (err error) {
+		if err = h.limiter.wait(ctx, request); err != nil {
+			return
+		}
+		var bytes []byte
+		if bytes, err = h.post(ctx, URL, contentType, body); err != nil {
+			return
+		}
+		h.log().Log(LevelDebug, "got response", "url", URL, "body", string(bytes))
 		result, err = decodeJsonResponse(bytes)
-	}
+		return
+	})
 	return
 }
 
@@ -166,6 +264,9 @@ func prepareMultipart(request map[string]interface{}) (contentType string, buffe
 		if errors.Is(err, errComplexType) {
 			fw, fr, err = prepareComplexValuePart(key, value, writer)
 		}
+		if err != nil {
+			return
+		}
 		if _, err = io.Copy(fw, fr); err != nil {
 			return
 		}
@@ -181,6 +282,11 @@ func prepareSimpleValuePart(name string, value interface{}, writer *multipart.Wr
 			return
 		}
 		fr = strings.NewReader(val)
+	case int:
+		if fw, err = writer.CreateFormField(name); err != nil {
+			return
+		}
+		fr = strings.NewReader(strconv.Itoa(val))
 	case int64:
 		if fw, err = writer.CreateFormField(name); err != nil {
 			return
@@ -204,7 +310,7 @@ func prepareSimpleValuePart(name string, value interface{}, writer *multipart.Wr
 
 func prepareComplexValuePart(name string, value interface{}, writer *multipart.Writer) (fw io.Writer, fr io.Reader, err error) {
 	switch unwrapped := value.(type) {
-	case axon.O, axon.A:
+	case axon.O, axon.A, map[string]interface{}, []interface{}, []string:
 		var dataBytes []byte
 		if dataBytes, err = json.Marshal(unwrapped); err != nil {
 			return
@@ -225,7 +331,7 @@ func prepareComplexValuePart(name string, value interface{}, writer *multipart.W
 		}
 		fr = bytes.NewBuffer(unwrapped.Data)
 	default:
-		log.Fatalf("Unsupported type %v", value)
+		err = errEncoding(fmt.Sprintf("unsupported type %T for field %v", value, name))
 	}
 	return
 }
@@ -0,0 +1,158 @@
+package ubot
+
+import (
+	"context"
+	"errors"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sdurz/axon"
+)
+
+func Test_decodeJsonResponse(t *testing.T) {
+	tests := []struct {
+		name       string
+		response   string
+		wantResult interface{}
+		wantErr    error
+	}{
+		{
+			name:       "ok response",
+			response:   `{"ok":true,"result":true}`,
+			wantResult: true,
+		},
+		{
+			name:     "bad request",
+			response: `{"ok":false,"error_code":400,"description":"Bad Request: chat not found"}`,
+			wantErr: &APIError{
+				ErrorCode:   400,
+				Description: "Bad Request: chat not found",
+			},
+		},
+		{
+			name:     "flood wait",
+			response: `{"ok":false,"error_code":429,"description":"Too Many Requests: retry after 5","parameters":{"retry_after":5}}`,
+			wantErr: &APIError{
+				ErrorCode:   429,
+				Description: "Too Many Requests: retry after 5",
+				Parameters:  ResponseParameters{RetryAfter: 5},
+			},
+		},
+		{
+			name:     "chat migrated",
+			response: `{"ok":false,"error_code":400,"description":"Bad Request: group chat was upgraded to a supergroup chat","parameters":{"migrate_to_chat_id":-1001234}}`,
+			wantErr: &APIError{
+				ErrorCode:   400,
+				Description: "Bad Request: group chat was upgraded to a supergroup chat",
+				Parameters:  ResponseParameters{MigrateToChatID: -1001234},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotResult, err := decodeJsonResponse([]byte(tt.response))
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Errorf("decodeJsonResponse() error = %v", err)
+					return
+				}
+				if !reflect.DeepEqual(gotResult, tt.wantResult) {
+					t.Errorf("decodeJsonResponse() = %v, want %v", gotResult, tt.wantResult)
+				}
+				return
+			}
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Errorf("decodeJsonResponse() error = %v, want an *APIError", err)
+				return
+			}
+			if !reflect.DeepEqual(apiErr, tt.wantErr) {
+				t.Errorf("decodeJsonResponse() error = %#v, want %#v", apiErr, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_httpApiClient_context(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	client := &httpApiClient{retryPolicy: RetryPolicy{MaxRetries: 3}}
+	if _, err := client.PostMultipart(ctx, server.URL, axon.O{"chat_id": "1"}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("httpApiClient.PostMultipart() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func Test_httpApiClient_redactsErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	// requests to a closed server fail with a *url.Error carrying the URL
+	server.Close()
+
+	token := "123:secret"
+	client := &httpApiClient{token: token}
+	URL := server.URL + "/bot" + token + "/getMe"
+	if _, err := client.GetBytes(context.Background(), URL); err == nil || strings.Contains(err.Error(), token) {
+		t.Errorf("httpApiClient.GetBytes() error = %v", err)
+	}
+	if _, err := client.PostBytes(context.Background(), URL, axon.O{}); err == nil || strings.Contains(err.Error(), token) {
+		t.Errorf("httpApiClient.PostBytes() error = %v", err)
+	}
+}
+
+func Test_prepareMultipart(t *testing.T) {
+	if _, _, err := prepareMultipart(axon.O{"chat_id": "1", "photo": UploadFile{FileName: "a.jpg"}}); err != nil {
+		t.Errorf("prepareMultipart() error = %v", err)
+	}
+	if _, _, err := prepareMultipart(axon.O{
+		"name":    "set_by_bot",
+		"sticker": UploadFile{FileName: "a.webp"},
+		"stickers": []interface{}{
+			map[string]interface{}{"sticker": "attach://sticker", "format": "static"},
+		},
+	}); err != nil {
+		t.Errorf("prepareMultipart() error = %v", err)
+	}
+	if _, _, err := prepareMultipart(axon.O{
+		"url":             "https://example.com/bot",
+		"certificate":     UploadFile{FileName: "cert.pem"},
+		"max_connections": 40,
+		"allowed_updates": []string{"message"},
+	}); err != nil {
+		t.Errorf("prepareMultipart() error = %v", err)
+	}
+	for _, chatID := range []interface{}{"123456789", 123456789, int64(123456789), 123456789.} {
+		contentType, buffer, err := prepareMultipart(axon.O{"chat_id": chatID, "photo": UploadFile{FileName: "a.jpg"}})
+		if err != nil {
+			t.Errorf("prepareMultipart() chat_id %T error = %v", chatID, err)
+			continue
+		}
+		_, params, _ := mime.ParseMediaType(contentType)
+		form, err := multipart.NewReader(buffer, params["boundary"]).ReadForm(1 << 20)
+		if err != nil {
+			t.Errorf("prepareMultipart() chat_id %T form error = %v", chatID, err)
+			continue
+		}
+		if got, err := strconv.ParseFloat(form.Value["chat_id"][0], 64); err != nil || got != 123456789 {
+			t.Errorf("prepareMultipart() chat_id %T = %q, want 123456789", chatID, form.Value["chat_id"][0])
+		}
+	}
+	if _, _, err := prepareMultipart(axon.O{"chat_id": struct{}{}}); err == nil {
+		t.Errorf("prepareMultipart() should fail on unsupported types")
+	}
+}
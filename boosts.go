@@ -0,0 +1,26 @@
+package ubot
+
+import (
+	"github.com/sdurz/axon"
+)
+
+// Chat boost sources
+// see https://core.telegram.org/bots/api#chatboostsource
+const (
+	BoostSourcePremium  = "premium"
+	BoostSourceGiftCode = "gift_code"
+	BoostSourceGiveaway = "giveaway"
+)
+
+// ChatBoostSource returns the source of a boost, its kind (one of the BoostSource constants)
+// and the whole ChatBoostSource object, which holds the booster user when known.
+// payload can be a chat_boost or removed_chat_boost update payload, or a ChatBoost object.
+func ChatBoostSource(payload axon.O) (kind string, source axon.O, err error) {
+	if source, err = payload.GetObject("boost.source"); err != nil {
+		if source, err = payload.GetObject("source"); err != nil {
+			return
+		}
+	}
+	kind, err = source.GetString("source")
+	return
+}
@@ -0,0 +1,74 @@
+package ubot
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sdurz/axon"
+)
+
+func Test_ChatBoostSource(t *testing.T) {
+	user := map[string]interface{}{"id": 123.}
+	tests := []struct {
+		name       string
+		payload    axon.O
+		wantKind   string
+		wantSource axon.O
+		wantErr    bool
+	}{
+		{
+			name: "chat_boost",
+			payload: axon.O{
+				"chat": map[string]interface{}{"id": -100.},
+				"boost": map[string]interface{}{
+					"boost_id": "1",
+					"source": map[string]interface{}{
+						"source": "premium",
+						"user":   user,
+					},
+				},
+			},
+			wantKind: BoostSourcePremium,
+			wantSource: axon.O{
+				"source": "premium",
+				"user":   user,
+			},
+		},
+		{
+			name: "removed_chat_boost",
+			payload: axon.O{
+				"chat":     map[string]interface{}{"id": -100.},
+				"boost_id": "1",
+				"source": map[string]interface{}{
+					"source":           "giveaway",
+					"giveaway_message": 5.,
+				},
+			},
+			wantKind: BoostSourceGiveaway,
+			wantSource: axon.O{
+				"source":           "giveaway",
+				"giveaway_message": 5.,
+			},
+		},
+		{
+			name:    "no source",
+			payload: axon.O{"chat": map[string]interface{}{"id": -100.}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotKind, gotSource, err := ChatBoostSource(tt.payload)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ChatBoostSource() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if gotKind != tt.wantKind {
+				t.Errorf("ChatBoostSource() kind = %v, want %v", gotKind, tt.wantKind)
+			}
+			if !reflect.DeepEqual(gotSource, tt.wantSource) {
+				t.Errorf("ChatBoostSource() source = %v, want %v", gotSource, tt.wantSource)
+			}
+		})
+	}
+}
@@ -4,52 +4,149 @@ package ubot
 import (
 	"context"
 	"errors"
-	"log"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
 	"sync"
 
 	"github.com/sdurz/axon"
 )
 
+// DefaultAPIEndpoint is the endpoint of the cloud Bot API server
+const DefaultAPIEndpoint = "https://api.telegram.org"
+
+// errNoAPIToken is returned by NewBotE for configurations without an APIToken
+var errNoAPIToken = errors.New("configuration has no APIToken")
+
 // Configuration struct holds configuration data for the bot
 type Configuration struct {
-	APIToken   string `json:"api_token"`
-	ServerPort string `json:"server_port"`
-	WebhookUrl string `json:"webhook_url"`
-	WorkerNo   int    `json:"worker_no"`
+	APIToken    string      `json:"api_token"`
+	ServerPort  string      `json:"server_port"`
+	WebhookUrl  string      `json:"webhook_url"`
+	WorkerNo    int         `json:"worker_no"`
+	RetryPolicy RetryPolicy `json:"retry_policy"`
+	RateLimits  RateLimits  `json:"rate_limits"`
+	// Logger receives the bot logs, defaults to NopLogger.
+	// The API token is always redacted from logged entries and returned errors.
+	Logger Logger `json:"-"`
+	// APIEndpoint is the Bot API server base URL, defaults to DefaultAPIEndpoint.
+	// Set it to use a self-hosted telegram-bot-api server.
+	APIEndpoint string `json:"api_endpoint"`
+	// TestEnvironment sends requests to the test environment.
+	TestEnvironment bool `json:"test_environment"`
+	// LocalMode tells that the self-hosted server runs with --local,
+	// so getFile returns absolute paths on the local filesystem.
+	LocalMode bool `json:"local_mode"`
+	// PollTimeout is the long polling timeout of GetUpdatesSource in seconds, defaults to DefaultPollTimeout.
+	PollTimeout int `json:"poll_timeout"`
+	// PollLimit is the maximum number of updates retrieved by GetUpdatesSource per request,
+	// from 1 to 100, defaults to 100.
+	PollLimit int `json:"poll_limit"`
+	// AllowedUpdates lists the update types to receive, such as "message" or "callback_query",
+	// with GetUpdatesSource and ServerSource. If empty the previous setting is kept.
+	AllowedUpdates []string `json:"allowed_updates"`
+	// WebhookSecretToken is the secret_token ServerSource sets with setWebhook and WebhookHandler
+	// expects in the X-Telegram-Bot-Api-Secret-Token header of webhook requests.
+	// A random one is generated if empty.
+	WebhookSecretToken string `json:"webhook_secret_token"`
+	// WebhookCheckIP makes ServerSource reject the requests not sent from TelegramSubnets.
+	WebhookCheckIP bool `json:"webhook_check_ip"`
+	// TrustedProxies are the CIDRs of the reverse proxies in front of ServerSource,
+	// the client address of their requests is read from X-Forwarded-For.
+	TrustedProxies []string `json:"trusted_proxies"`
+	// WebhookReply allows handlers to answer webhook updates in the webhook response, see WebhookReply.
+	WebhookReply bool `json:"webhook_reply"`
+	// WebhookAsync acknowledges webhook updates before processing them in the worker pool,
+	// see WebhookHandler.Async.
+	WebhookAsync bool `json:"webhook_async"`
+	// WebhookRetryOnError answers 500 to the webhook requests whose handler fails with a Transient
+	// error, so that the update is delivered again, see WebhookHandler.RetryOnError.
+	WebhookRetryOnError bool `json:"webhook_retry_on_error"`
+	// WebhookCertFile and WebhookKeyFile are the PEM encoded certificate and key ServerSource
+	// serves HTTPS with.
+	WebhookCertFile string `json:"webhook_cert_file"`
+	WebhookKeyFile  string `json:"webhook_key_file"`
+	// WebhookUploadCert uploads WebhookCertFile with setWebhook, as required for self-signed certificates.
+	WebhookUploadCert bool `json:"webhook_upload_cert"`
+	// WebhookSelfSignedHost makes ServerSource serve HTTPS with a self-signed certificate
+	// generated for this host name or IP address, and upload it with setWebhook.
+	// It's ignored if WebhookCertFile is set.
+	WebhookSelfSignedHost string `json:"webhook_self_signed_host"`
+	// WebhookIPAddress is the IP address the API server sends webhook requests to, instead of
+	// the one resolved through DNS.
+	WebhookIPAddress string `json:"webhook_ip_address"`
+	// WebhookMaxConnections is the maximum number of simultaneous webhook connections,
+	// from 1 to 100, defaults to 40.
+	WebhookMaxConnections int `json:"webhook_max_connections"`
+	// WebhookDropPendingUpdates drops the updates pending when ServerSource sets the webhook.
+	WebhookDropPendingUpdates bool `json:"webhook_drop_pending_updates"`
 }
 
 // Bot is the main type of ubot.
 // It implements a bot API frontend.
 type Bot struct {
-	Configuration         Configuration
-	apiClient             apiClient
-	BotUser               User
-	messageMHs            []matcherHandler
-	editedMessageMHs      []matcherHandler
-	channelPostMHs        []matcherHandler
-	editedChannelPostMHs  []matcherHandler
-	inlineQueryMHs        []matcherHandler
-	chosenInlineResultMHs []matcherHandler
-	callbackQueryMHs      []matcherHandler
-	shippingQueryMHs      []matcherHandler
-	preCheckoutQueryMHs   []matcherHandler
-	pollMHs               []matcherHandler
-	pollAnswerMHs         []matcherHandler
-	myChatMemberMHs       []matcherHandler
-	chatMemberMHs         []matcherHandler
-}
-
-// NewBot creates a new Bot for the given configuration
+	Configuration              Configuration
+	apiClient                  apiClient
+	errorHandler               ErrorHandler
+	logger                     Logger
+	workersOnce                sync.Once
+	workers                    chan struct{}
+	BotUser                    User
+	messageMHs                 []matcherHandler
+	editedMessageMHs           []matcherHandler
+	channelPostMHs             []matcherHandler
+	editedChannelPostMHs       []matcherHandler
+	inlineQueryMHs             []matcherHandler
+	chosenInlineResultMHs      []matcherHandler
+	callbackQueryMHs           []matcherHandler
+	shippingQueryMHs           []matcherHandler
+	preCheckoutQueryMHs        []matcherHandler
+	pollMHs                    []matcherHandler
+	pollAnswerMHs              []matcherHandler
+	myChatMemberMHs            []matcherHandler
+	chatMemberMHs              []matcherHandler
+	chatJoinRequestMHs         []matcherHandler
+	messageReactionMHs         []matcherHandler
+	messageReactionCountMHs    []matcherHandler
+	chatBoostMHs               []matcherHandler
+	removedChatBoostMHs        []matcherHandler
+	businessConnectionMHs      []matcherHandler
+	businessMessageMHs         []matcherHandler
+	editedBusinessMessageMHs   []matcherHandler
+	deletedBusinessMessagesMHs []matcherHandler
+}
+
+// NewBot creates a new Bot for the given configuration.
+// It's like NewBotE but panics if the configuration is not valid,
+// it's meant for configurations known in advance.
 func NewBot(configuration *Configuration) (result *Bot) {
+	var err error
+	if result, err = NewBotE(configuration); err != nil {
+		panic(err)
+	}
+	return
+}
+
+// NewBotE creates a new Bot for the given configuration.
+// It fails if the configuration has no APIToken.
+func NewBotE(configuration *Configuration) (result *Bot, err error) {
 	if configuration.APIToken == "" {
-		log.Fatalf("configuration has no APIToken")
+		err = errNoAPIToken
+		return
 	}
 	if configuration.WorkerNo == 0 {
 		configuration.WorkerNo = 5
 	}
+	logger := newRedactingLogger(configuration.Logger, configuration.APIToken)
 	result = &Bot{
 		Configuration: *configuration,
-		apiClient:     &httpApiClient{},
+		apiClient: &httpApiClient{
+			retryPolicy: configuration.RetryPolicy,
+			logger:      logger,
+			token:       configuration.APIToken,
+			limiter:     newRateLimiter(configuration.RateLimits),
+		},
+		logger: logger,
 	}
 	return
 }
@@ -133,33 +230,140 @@ func (b *Bot) AddChatMemberHandler(matcher Matcher, handler Handler) {
 	b.chatMemberMHs = append(b.chatMemberMHs, matcherHandler{matcher: matcher, handler: handler})
 }
 
+// AddChatJoinRequestHandler adds an handler for chat_join_request updates.
+func (b *Bot) AddChatJoinRequestHandler(matcher Matcher, handler Handler) {
+	b.chatJoinRequestMHs = append(b.chatJoinRequestMHs, matcherHandler{matcher: matcher, handler: handler})
+}
+
+// AddMessageReactionHandler adds an handler for message_reaction updates.
+func (b *Bot) AddMessageReactionHandler(matcher Matcher, handler Handler) {
+	b.messageReactionMHs = append(b.messageReactionMHs, matcherHandler{matcher: matcher, handler: handler})
+}
+
+// AddMessageReactionCountHandler adds an handler for message_reaction_count updates.
+func (b *Bot) AddMessageReactionCountHandler(matcher Matcher, handler Handler) {
+	b.messageReactionCountMHs = append(b.messageReactionCountMHs, matcherHandler{matcher: matcher, handler: handler})
+}
+
+// AddChatBoostHandler adds an handler for chat_boost updates.
+func (b *Bot) AddChatBoostHandler(matcher Matcher, handler Handler) {
+	b.chatBoostMHs = append(b.chatBoostMHs, matcherHandler{matcher: matcher, handler: handler})
+}
+
+// AddRemovedChatBoostHandler adds an handler for removed_chat_boost updates.
+func (b *Bot) AddRemovedChatBoostHandler(matcher Matcher, handler Handler) {
+	b.removedChatBoostMHs = append(b.removedChatBoostMHs, matcherHandler{matcher: matcher, handler: handler})
+}
+
+// AddBusinessConnectionHandler adds an handler for business_connection updates.
+func (b *Bot) AddBusinessConnectionHandler(matcher Matcher, handler Handler) {
+	b.businessConnectionMHs = append(b.businessConnectionMHs, matcherHandler{matcher: matcher, handler: handler})
+}
+
+// AddBusinessMessageHandler adds an handler for business_message updates.
+func (b *Bot) AddBusinessMessageHandler(matcher Matcher, handler Handler) {
+	b.businessMessageMHs = append(b.businessMessageMHs, matcherHandler{matcher: matcher, handler: handler})
+}
+
+// AddEditedBusinessMessageHandler adds an handler for edited_business_message updates.
+func (b *Bot) AddEditedBusinessMessageHandler(matcher Matcher, handler Handler) {
+	b.editedBusinessMessageMHs = append(b.editedBusinessMessageMHs, matcherHandler{matcher: matcher, handler: handler})
+}
+
+// AddDeletedBusinessMessagesHandler adds an handler for deleted_business_messages updates.
+func (b *Bot) AddDeletedBusinessMessagesHandler(matcher Matcher, handler Handler) {
+	b.deletedBusinessMessagesMHs = append(b.deletedBusinessMessagesMHs, matcherHandler{matcher: matcher, handler: handler})
+}
+
+// SetErrorHandler sets the handler that is notified of errors raised by the update source or by handlers.
+// By default errors are logged at LevelError.
+func (b *Bot) SetErrorHandler(handler ErrorHandler) {
+	b.errorHandler = handler
+}
+
+// reportError notifies err to the error handler
+func (b *Bot) reportError(ctx context.Context, err error) {
+	if b.errorHandler != nil {
+		b.errorHandler(ctx, b, err)
+	} else {
+		b.log().Log(LevelError, "bot error", "error", err)
+	}
+}
+
+// log returns the bot Logger, falling back to NopLogger
+func (b *Bot) log() Logger {
+	if b == nil || b.logger == nil {
+		return NopLogger{}
+	}
+	return b.logger
+}
+
 // Forever starts the bot and processes updates until context is done.
+// It returns an error if the bot user can't be retrieved, processing errors are reported to the ErrorHandler.
 func (b *Bot) Forever(ctx context.Context, wg *sync.WaitGroup, source UpdatesSource) error {
 	defer wg.Done()
 
-	if user, err := b.GetMe(); err == nil {
+	if user, err := b.GetMe(ctx); err == nil {
 		b.BotUser = *user
 	} else {
-		log.Fatal(err)
+		return err
 	}
 
 	updates := make(chan axon.O)
 	go source(b, ctx, updates)
 
-	semaphore := make(chan int, b.Configuration.WorkerNo)
 	for {
 		select {
 		case <-ctx.Done():
-			log.Println("forever is over")
+			b.log().Log(LevelInfo, "forever is over")
 			return nil
 		case update := <-updates:
-			semaphore <- 1
-			go func() {
-				b.process(ctx, update)
-				<-semaphore
-			}()
+			b.dispatch(ctx, ctx, update)
+		}
+	}
+}
+
+// workerPool returns the semaphore that bounds to WorkerNo the updates processed concurrently
+func (b *Bot) workerPool() chan struct{} {
+	b.workersOnce.Do(func() {
+		workerNo := b.Configuration.WorkerNo
+		if workerNo <= 0 {
+			workerNo = 1
+		}
+		b.workers = make(chan struct{}, workerNo)
+	})
+	return b.workers
+}
+
+// dispatch processes update within ctx in the worker pool, waiting for a free worker
+// until waitCtx is done. It returns the waitCtx error if no worker got free in time.
+func (b *Bot) dispatch(ctx context.Context, waitCtx context.Context, update axon.O) error {
+	workers := b.workerPool()
+	select {
+	case workers <- struct{}{}:
+	case <-waitCtx.Done():
+		return waitCtx.Err()
+	}
+	go func() {
+		defer func() { <-workers }()
+		if err := b.process(ctx, update); err != nil {
+			b.reportError(ctx, err)
 		}
+	}()
+	return nil
+}
+
+// baseURL returns the bot URL prefix for the given kind of resources ("bot" or "file/bot")
+func (b *Bot) baseURL(kind string) (result string) {
+	endpoint := b.Configuration.APIEndpoint
+	if endpoint == "" {
+		endpoint = DefaultAPIEndpoint
+	}
+	result = strings.TrimSuffix(endpoint, "/") + "/" + kind + b.Configuration.APIToken
+	if b.Configuration.TestEnvironment {
+		result += "/test"
 	}
+	return
 }
 
 // methodURL transforms a method name in the corresponding API url
@@ -167,7 +371,26 @@ func (b *Bot) methodURL(method string) (result string) {
 	if method == "" {
 		panic("Emtpy method")
 	}
-	result = "https://api.telegram.org/bot" + b.Configuration.APIToken + "/" + method
+	result = b.baseURL("bot") + "/" + method
+	return
+}
+
+// FileURL returns the download URL for a file_path obtained through GetFile.
+func (b *Bot) FileURL(filePath string) (result string) {
+	result = b.baseURL("file/bot") + "/" + filePath
+	return
+}
+
+// DownloadFile downloads the content of a file_path obtained through GetFile.
+// In LocalMode absolute paths are read from the local filesystem.
+func (b *Bot) DownloadFile(ctx context.Context, filePath string) (result []byte, err error) {
+	if b.Configuration.LocalMode && filepath.IsAbs(filePath) {
+		return ioutil.ReadFile(filePath)
+	}
+	// failed downloads are reported by GetBytes, with the error status of the reply
+	if result, err = b.apiClient.GetBytes(ctx, b.FileURL(filePath)); err != nil {
+		result = nil
+	}
 	return
 }
 
@@ -205,6 +428,24 @@ func (b *Bot) process(ctx context.Context, update axon.O) (err error) {
 		matcherHandlers = b.myChatMemberMHs
 	} else if rawPayload, ok = update["chat_member"]; ok {
 		matcherHandlers = b.chatMemberMHs
+	} else if rawPayload, ok = update["chat_join_request"]; ok {
+		matcherHandlers = b.chatJoinRequestMHs
+	} else if rawPayload, ok = update["message_reaction"]; ok {
+		matcherHandlers = b.messageReactionMHs
+	} else if rawPayload, ok = update["message_reaction_count"]; ok {
+		matcherHandlers = b.messageReactionCountMHs
+	} else if rawPayload, ok = update["chat_boost"]; ok {
+		matcherHandlers = b.chatBoostMHs
+	} else if rawPayload, ok = update["removed_chat_boost"]; ok {
+		matcherHandlers = b.removedChatBoostMHs
+	} else if rawPayload, ok = update["business_connection"]; ok {
+		matcherHandlers = b.businessConnectionMHs
+	} else if rawPayload, ok = update["business_message"]; ok {
+		matcherHandlers = b.businessMessageMHs
+	} else if rawPayload, ok = update["edited_business_message"]; ok {
+		matcherHandlers = b.editedBusinessMessageMHs
+	} else if rawPayload, ok = update["deleted_business_messages"]; ok {
+		matcherHandlers = b.deletedBusinessMessagesMHs
 	} else {
 		err = errors.New("update without data")
 	}
@@ -215,7 +456,6 @@ func (b *Bot) process(ctx context.Context, update axon.O) (err error) {
 	if err == nil {
 		for _, ha := range matcherHandlers {
 			if stop, err = ha.evaluate(ctx, b, payload); err != nil {
-				log.Println(err)
 				break
 			}
 			if stop {
@@ -226,14 +466,18 @@ func (b *Bot) process(ctx context.Context, update axon.O) (err error) {
 	return
 }
 
-func (b *Bot) doGet(method string) (interface{}, error) {
-	return b.apiClient.GetJson(b.methodURL(method))
+func (b *Bot) doGet(ctx context.Context, method string) (interface{}, error) {
+	return b.apiClient.GetJson(ctx, b.methodURL(method))
 }
 
-func (b *Bot) doPost(method string, request axon.O) (interface{}, error) {
-	return b.apiClient.PostJson(b.methodURL(method), request)
+// doPost posts request as JSON, a nil request is sent as an empty object.
+func (b *Bot) doPost(ctx context.Context, method string, request axon.O) (interface{}, error) {
+	if request == nil {
+		request = axon.O{}
+	}
+	return b.apiClient.PostJson(ctx, b.methodURL(method), request)
 }
 
-func (b *Bot) doPostMultipart(method string, request axon.O) (interface{}, error) {
-	return b.apiClient.PostMultipart(b.methodURL(method), request)
+func (b *Bot) doPostMultipart(ctx context.Context, method string, request axon.O) (interface{}, error) {
+	return b.apiClient.PostMultipart(ctx, b.methodURL(method), request)
 }
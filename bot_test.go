@@ -2,25 +2,39 @@ package ubot
 
 import (
 	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
 	"reflect"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/sdurz/axon"
 )
 
 func TestBot_process(t *testing.T) {
 	type fields struct {
-		Configuration         Configuration
-		BotUser               User
-		messageMHs            []matcherHandler
-		editedMessageMHs      []matcherHandler
-		channelPostMHs        []matcherHandler
-		editedChannelPostMHs  []matcherHandler
-		inlineQueryMHs        []matcherHandler
-		chosenInlineResultMHs []matcherHandler
-		callbackQueryMHs      []matcherHandler
-		myChatMemberMHs       []matcherHandler
-		chatMemberMHs         []matcherHandler
+		Configuration              Configuration
+		BotUser                    User
+		messageMHs                 []matcherHandler
+		editedMessageMHs           []matcherHandler
+		channelPostMHs             []matcherHandler
+		editedChannelPostMHs       []matcherHandler
+		inlineQueryMHs             []matcherHandler
+		chosenInlineResultMHs      []matcherHandler
+		callbackQueryMHs           []matcherHandler
+		myChatMemberMHs            []matcherHandler
+		chatMemberMHs              []matcherHandler
+		chatJoinRequestMHs         []matcherHandler
+		messageReactionMHs         []matcherHandler
+		messageReactionCountMHs    []matcherHandler
+		chatBoostMHs               []matcherHandler
+		removedChatBoostMHs        []matcherHandler
+		businessMessageMHs         []matcherHandler
+		deletedBusinessMessagesMHs []matcherHandler
 	}
 	type args struct {
 		ctx    context.Context
@@ -171,23 +185,121 @@ func TestBot_process(t *testing.T) {
 			wantInvocations: 1,
 			wantErr:         false,
 		},
+		{
+			name: "a base chat_join_request test",
+			fields: fields{
+				chatJoinRequestMHs: []matcherHandler{mhStop},
+			},
+			args: args{
+				update: axon.O{
+					"chat_join_request": map[string]interface{}{},
+				},
+			},
+			wantInvocations: 1,
+			wantErr:         false,
+		},
+		{
+			name: "a base message_reaction test",
+			fields: fields{
+				messageReactionMHs: []matcherHandler{mhStop},
+			},
+			args: args{
+				update: axon.O{
+					"message_reaction": map[string]interface{}{},
+				},
+			},
+			wantInvocations: 1,
+			wantErr:         false,
+		},
+		{
+			name: "a base message_reaction_count test",
+			fields: fields{
+				messageReactionCountMHs: []matcherHandler{mhStop},
+			},
+			args: args{
+				update: axon.O{
+					"message_reaction_count": map[string]interface{}{},
+				},
+			},
+			wantInvocations: 1,
+			wantErr:         false,
+		},
+		{
+			name: "a base chat_boost test",
+			fields: fields{
+				chatBoostMHs: []matcherHandler{mhStop},
+			},
+			args: args{
+				update: axon.O{
+					"chat_boost": map[string]interface{}{},
+				},
+			},
+			wantInvocations: 1,
+			wantErr:         false,
+		},
+		{
+			name: "a base removed_chat_boost test",
+			fields: fields{
+				removedChatBoostMHs: []matcherHandler{mhStop},
+			},
+			args: args{
+				update: axon.O{
+					"removed_chat_boost": map[string]interface{}{},
+				},
+			},
+			wantInvocations: 1,
+			wantErr:         false,
+		},
+		{
+			name: "a base business_message test",
+			fields: fields{
+				businessMessageMHs: []matcherHandler{mhStop},
+			},
+			args: args{
+				update: axon.O{
+					"business_message": map[string]interface{}{},
+				},
+			},
+			wantInvocations: 1,
+			wantErr:         false,
+		},
+		{
+			name: "a base deleted_business_messages test",
+			fields: fields{
+				deletedBusinessMessagesMHs: []matcherHandler{mhStop},
+			},
+			args: args{
+				update: axon.O{
+					"deleted_business_messages": map[string]interface{}{},
+				},
+			},
+			wantInvocations: 1,
+			wantErr:         false,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			invocations = 0
 			b := &Bot{
-				Configuration:         tt.fields.Configuration,
-				BotUser:               tt.fields.BotUser,
-				messageMHs:            tt.fields.messageMHs,
-				editedMessageMHs:      tt.fields.editedMessageMHs,
-				channelPostMHs:        tt.fields.channelPostMHs,
-				editedChannelPostMHs:  tt.fields.editedChannelPostMHs,
-				inlineQueryMHs:        tt.fields.inlineQueryMHs,
-				chosenInlineResultMHs: tt.fields.chosenInlineResultMHs,
-				callbackQueryMHs:      tt.fields.callbackQueryMHs,
-				myChatMemberMHs:       tt.fields.myChatMemberMHs,
-				chatMemberMHs:         tt.fields.chatMemberMHs,
+				Configuration:              tt.fields.Configuration,
+				BotUser:                    tt.fields.BotUser,
+				messageMHs:                 tt.fields.messageMHs,
+				editedMessageMHs:           tt.fields.editedMessageMHs,
+				channelPostMHs:             tt.fields.channelPostMHs,
+				editedChannelPostMHs:       tt.fields.editedChannelPostMHs,
+				inlineQueryMHs:             tt.fields.inlineQueryMHs,
+				chosenInlineResultMHs:      tt.fields.chosenInlineResultMHs,
+				callbackQueryMHs:           tt.fields.callbackQueryMHs,
+				myChatMemberMHs:            tt.fields.myChatMemberMHs,
+				chatMemberMHs:              tt.fields.chatMemberMHs,
+				chatJoinRequestMHs:         tt.fields.chatJoinRequestMHs,
+				messageReactionMHs:         tt.fields.messageReactionMHs,
+				messageReactionCountMHs:    tt.fields.messageReactionCountMHs,
+				chatBoostMHs:               tt.fields.chatBoostMHs,
+				removedChatBoostMHs:        tt.fields.removedChatBoostMHs,
+				businessMessageMHs:         tt.fields.businessMessageMHs,
+				deletedBusinessMessagesMHs: tt.fields.deletedBusinessMessagesMHs,
 			}
 			if err := b.process(tt.args.ctx, tt.args.update); (err != nil) != tt.wantErr {
 				t.Errorf("Bot.process() error = %v, wantErr %v", err, tt.wantErr)
@@ -210,6 +322,127 @@ func TestBot_methodURL(t *testing.T) {
 	b.methodURL("")
 }
 
+func TestBot_URLs(t *testing.T) {
+	tests := []struct {
+		name          string
+		configuration Configuration
+		wantMethodURL string
+		wantFileURL   string
+	}{
+		{
+			name:          "cloud api",
+			configuration: Configuration{APIToken: "123:abc"},
+			wantMethodURL: "https://api.telegram.org/bot123:abc/getMe",
+			wantFileURL:   "https://api.telegram.org/file/bot123:abc/photos/file_1.jpg",
+		},
+		{
+			name:          "test environment",
+			configuration: Configuration{APIToken: "123:abc", TestEnvironment: true},
+			wantMethodURL: "https://api.telegram.org/bot123:abc/test/getMe",
+			wantFileURL:   "https://api.telegram.org/file/bot123:abc/test/photos/file_1.jpg",
+		},
+		{
+			name:          "self hosted",
+			configuration: Configuration{APIToken: "123:abc", APIEndpoint: "http://localhost:8081/"},
+			wantMethodURL: "http://localhost:8081/bot123:abc/getMe",
+			wantFileURL:   "http://localhost:8081/file/bot123:abc/photos/file_1.jpg",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{Configuration: tt.configuration}
+			if got := b.methodURL("getMe"); got != tt.wantMethodURL {
+				t.Errorf("Bot.methodURL() = %v, want %v", got, tt.wantMethodURL)
+			}
+			if got := b.FileURL("photos/file_1.jpg"); got != tt.wantFileURL {
+				t.Errorf("Bot.FileURL() = %v, want %v", got, tt.wantFileURL)
+			}
+		})
+	}
+}
+
+func TestBot_DownloadFile(t *testing.T) {
+	localFile, err := ioutil.TempFile("", "ubot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(localFile.Name())
+	localFile.Write([]byte("local content"))
+	localFile.Close()
+
+	tests := []struct {
+		name          string
+		configuration Configuration
+		filePath      string
+		status        int
+		response      string
+		wantResult    []byte
+		wantErr       bool
+	}{
+		{
+			name:       "remote file",
+			filePath:   "photos/file_1.jpg",
+			status:     http.StatusOK,
+			response:   "remote content",
+			wantResult: []byte("remote content"),
+		},
+		{
+			name:       "remote JSON file",
+			filePath:   "documents/settings.json",
+			status:     http.StatusOK,
+			response:   `{"name":"settings","value":1}`,
+			wantResult: []byte(`{"name":"settings","value":1}`),
+		},
+		{
+			name:     "remote file not found",
+			filePath: "photos/file_1.jpg",
+			status:   http.StatusNotFound,
+			response: `{"ok":false,"error_code":404,"description":"Not Found"}`,
+			wantErr:  true,
+		},
+		{
+			name:     "remote server error",
+			filePath: "photos/file_1.jpg",
+			status:   http.StatusBadGateway,
+			response: "<html>bad gateway</html>",
+			wantErr:  true,
+		},
+		{
+			name:          "local file",
+			configuration: Configuration{LocalMode: true},
+			filePath:      localFile.Name(),
+			wantResult:    []byte("local content"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/file/bot123:abc/"+tt.filePath {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.response))
+			}))
+			defer server.Close()
+			tt.configuration.APIToken = "123:abc"
+			tt.configuration.APIEndpoint = server.URL
+			b := &Bot{
+				Configuration: tt.configuration,
+				apiClient:     &httpApiClient{},
+			}
+			gotResult, err := b.DownloadFile(context.Background(), tt.filePath)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.DownloadFile() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(gotResult, tt.wantResult) {
+				t.Errorf("Bot.DownloadFile() = %s, want %s", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
 func Test_matcherHandler_evaluate(t *testing.T) {
 	type fields struct {
 		matcher Matcher
@@ -283,6 +516,71 @@ func Test_matcherHandler_evaluate(t *testing.T) {
 	}
 }
 
+func TestBot_Forever(t *testing.T) {
+	var wg sync.WaitGroup
+	wg.Add(1)
+	b := &Bot{apiClient: &failingAPIClient{err: errors.New("dns glitch")}}
+	source := func(*Bot, context.Context, chan axon.O) {}
+	if err := b.Forever(context.Background(), &wg, source); err == nil {
+		t.Errorf("Bot.Forever() should return the GetMe error")
+	}
+	wg.Wait()
+}
+
+func TestBot_dispatch(t *testing.T) {
+	var (
+		mutex      sync.Mutex
+		running    int
+		maxRunning int
+		wg         sync.WaitGroup
+	)
+	b := &Bot{Configuration: Configuration{WorkerNo: 2}}
+	b.AddMessageHandler(Always, func(ctx context.Context, bot *Bot, message axon.O) (bool, error) {
+		mutex.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		mutex.Unlock()
+		time.Sleep(5 * time.Millisecond)
+		mutex.Lock()
+		running--
+		mutex.Unlock()
+		wg.Done()
+		return true, nil
+	})
+
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		if err := b.dispatch(context.Background(), context.Background(), axon.O{"message": map[string]interface{}{}}); err != nil {
+			t.Fatalf("Bot.dispatch() error = %v", err)
+		}
+	}
+	wg.Wait()
+	if maxRunning != 2 {
+		t.Errorf("Bot.dispatch() ran %v updates concurrently, want 2", maxRunning)
+	}
+}
+
+func Test_NewBotE_noToken(t *testing.T) {
+	gotResult, err := NewBotE(&Configuration{})
+	if err != errNoAPIToken {
+		t.Errorf("NewBotE() error = %v, want %v", err, errNoAPIToken)
+	}
+	if gotResult != nil {
+		t.Errorf("NewBotE() = %v, want nil", gotResult)
+	}
+}
+
+func Test_NewBot_noToken(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("NewBot() didn't panic")
+		}
+	}()
+	NewBot(&Configuration{})
+}
+
 func Test_NewBot(t *testing.T) {
 	type args struct {
 		configuration *Configuration
@@ -305,7 +603,11 @@ func Test_NewBot(t *testing.T) {
 					APIToken: "!23",
 					WorkerNo: 5,
 				},
-				apiClient: &httpApiClient{},
+				apiClient: &httpApiClient{
+					logger: &redactingLogger{logger: NopLogger{}, token: "!23"},
+					token:  "!23",
+				},
+				logger: &redactingLogger{logger: NopLogger{}, token: "!23"},
 			},
 		},
 		{
@@ -321,7 +623,11 @@ func Test_NewBot(t *testing.T) {
 					APIToken: "!23",
 					WorkerNo: 10,
 				},
-				apiClient: &httpApiClient{},
+				apiClient: &httpApiClient{
+					logger: &redactingLogger{logger: NopLogger{}, token: "!23"},
+					token:  "!23",
+				},
+				logger: &redactingLogger{logger: NopLogger{}, token: "!23"},
 			},
 		},
 	}
@@ -330,6 +636,13 @@ func Test_NewBot(t *testing.T) {
 			if gotResult := NewBot(tt.args.configuration); !reflect.DeepEqual(gotResult, tt.wantResult) {
 				t.Errorf("NewBot() = %v, want %v", gotResult, tt.wantResult)
 			}
+			gotResult, err := NewBotE(tt.args.configuration)
+			if err != nil {
+				t.Errorf("NewBotE() error = %v", err)
+			}
+			if !reflect.DeepEqual(gotResult, tt.wantResult) {
+				t.Errorf("NewBotE() = %v, want %v", gotResult, tt.wantResult)
+			}
 		})
 	}
 }
@@ -0,0 +1,36 @@
+package ubot
+
+import (
+	"github.com/sdurz/axon"
+)
+
+// BusinessConnectionID returns the business connection id of an incoming business_connection,
+// of a business message, of a deleted_business_messages payload or of a callback query sent
+// from a business message.
+func BusinessConnectionID(payload axon.O) (result string, ok bool) {
+	var err error
+	if result, err = payload.GetString("business_connection_id"); err == nil {
+		ok = true
+	} else if result, err = payload.GetString("message.business_connection_id"); err == nil {
+		ok = true
+	} else if _, err = payload.GetInteger("user_chat_id"); err == nil {
+		// a BusinessConnection carries its id in the id field
+		result, err = payload.GetString("id")
+		ok = err == nil
+	}
+	return
+}
+
+// WithBusinessConnection sets the business_connection_id of the incoming payload on request,
+// so that the request is sent on behalf of the connected business account.
+// request is left untouched if incoming doesn't come from a business connection,
+// a nil request is replaced by a new one.
+func WithBusinessConnection(request axon.O, incoming axon.O) axon.O {
+	if request == nil {
+		request = axon.O{}
+	}
+	if connectionID, ok := BusinessConnectionID(incoming); ok {
+		request["business_connection_id"] = connectionID
+	}
+	return request
+}
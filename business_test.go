@@ -0,0 +1,120 @@
+package ubot
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sdurz/axon"
+)
+
+func Test_BusinessConnectionID(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload axon.O
+		want    string
+		wantOk  bool
+	}{
+		{
+			name: "business_message",
+			payload: axon.O{
+				"message_id":             1.,
+				"business_connection_id": "abc",
+			},
+			want:   "abc",
+			wantOk: true,
+		},
+		{
+			name: "callback query from a business message",
+			payload: axon.O{
+				"id": "42",
+				"message": map[string]interface{}{
+					"message_id":             1.,
+					"business_connection_id": "abc",
+				},
+			},
+			want:   "abc",
+			wantOk: true,
+		},
+		{
+			name: "business_connection",
+			payload: axon.O{
+				"id":           "abc",
+				"user":         map[string]interface{}{"id": 42.},
+				"user_chat_id": 42.,
+				"date":         1700000000.,
+				"is_enabled":   true,
+			},
+			want:   "abc",
+			wantOk: true,
+		},
+		{
+			name: "callback query from a regular message",
+			payload: axon.O{
+				"id": "42",
+				"message": map[string]interface{}{
+					"message_id": 1.,
+				},
+			},
+			wantOk: false,
+		},
+		{
+			name: "regular message",
+			payload: axon.O{
+				"message_id": 1.,
+			},
+			wantOk: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotOk := BusinessConnectionID(tt.payload)
+			if gotOk != tt.wantOk {
+				t.Errorf("BusinessConnectionID() ok = %v, want %v", gotOk, tt.wantOk)
+			}
+			if got != tt.want {
+				t.Errorf("BusinessConnectionID() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_WithBusinessConnection(t *testing.T) {
+	tests := []struct {
+		name     string
+		request  axon.O
+		incoming axon.O
+		want     axon.O
+	}{
+		{
+			name:     "business_message",
+			request:  axon.O{"chat_id": 1., "text": "hi"},
+			incoming: axon.O{"business_connection_id": "abc"},
+			want:     axon.O{"chat_id": 1., "text": "hi", "business_connection_id": "abc"},
+		},
+		{
+			name:     "regular message",
+			request:  axon.O{"chat_id": 1., "text": "hi"},
+			incoming: axon.O{"message_id": 1.},
+			want:     axon.O{"chat_id": 1., "text": "hi"},
+		},
+		{
+			name:     "nil request",
+			request:  nil,
+			incoming: axon.O{"business_connection_id": "abc"},
+			want:     axon.O{"business_connection_id": "abc"},
+		},
+		{
+			name:     "nil request, regular message",
+			request:  nil,
+			incoming: axon.O{"message_id": 1.},
+			want:     axon.O{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := WithBusinessConnection(tt.request, tt.incoming); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("WithBusinessConnection() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package ubot
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"time"
+)
+
+// selfSignedValidity is the validity period of generated self-signed certificates
+var selfSignedValidity = 365 * 24 * time.Hour
+
+// generateSelfSignedCert returns a PEM encoded self-signed certificate for host,
+// a DNS name or an IP address, and its private key.
+func generateSelfSignedCert(host string, now time.Time) (certPEM, keyPEM []byte, err error) {
+	var (
+		key    *rsa.PrivateKey
+		serial *big.Int
+		der    []byte
+	)
+	if key, err = rsa.GenerateKey(rand.Reader, 2048); err != nil {
+		return
+	}
+	if serial, err = rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128)); err != nil {
+		return
+	}
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: host},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(selfSignedValidity),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		template.IPAddresses = []net.IP{ip}
+	} else {
+		template.DNSNames = []string{host}
+	}
+	if der, err = x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key); err != nil {
+		return
+	}
+	certPEM = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM = pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	return
+}
+
+// webhookTLS returns the TLS configuration of ServerSource, nil to serve plain HTTP,
+// and the certificate to upload with setWebhook, if any.
+func webhookTLS(configuration Configuration) (tlsConfig *tls.Config, upload []byte, err error) {
+	var certPEM, keyPEM []byte
+	switch {
+	case configuration.WebhookCertFile != "":
+		if certPEM, err = ioutil.ReadFile(configuration.WebhookCertFile); err != nil {
+			return
+		}
+		if keyPEM, err = ioutil.ReadFile(configuration.WebhookKeyFile); err != nil {
+			return
+		}
+		if configuration.WebhookUploadCert {
+			upload = certPEM
+		}
+	case configuration.WebhookSelfSignedHost != "":
+		if certPEM, keyPEM, err = generateSelfSignedCert(configuration.WebhookSelfSignedHost, time.Now()); err != nil {
+			err = fmt.Errorf("can't generate a self-signed certificate: %w", err)
+			return
+		}
+		upload = certPEM
+	default:
+		return
+	}
+
+	var certificate tls.Certificate
+	if certificate, err = tls.X509KeyPair(certPEM, keyPEM); err != nil {
+		upload = nil
+		return
+	}
+	tlsConfig = &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		MinVersion:   tls.VersionTLS12,
+	}
+	return
+}
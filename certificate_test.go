@@ -0,0 +1,119 @@
+package ubot
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func Test_generateSelfSignedCert(t *testing.T) {
+	tests := []struct {
+		name string
+		host string
+	}{
+		{
+			name: "host name",
+			host: "bot.example.com",
+		},
+		{
+			name: "ip address",
+			host: "203.0.113.7",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := time.Now()
+			certPEM, keyPEM, err := generateSelfSignedCert(tt.host, now)
+			if err != nil {
+				t.Fatalf("generateSelfSignedCert() error = %v", err)
+			}
+			block, _ := pem.Decode(certPEM)
+			if block == nil || len(keyPEM) == 0 {
+				t.Fatalf("generateSelfSignedCert() returned no PEM data")
+			}
+			certificate, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				t.Fatalf("generateSelfSignedCert() certificate error = %v", err)
+			}
+			if err = certificate.VerifyHostname(tt.host); err != nil {
+				t.Errorf("generateSelfSignedCert() certificate doesn't match host: %v", err)
+			}
+			if !certificate.NotAfter.After(now) {
+				t.Errorf("generateSelfSignedCert() certificate expired at %v", certificate.NotAfter)
+			}
+		})
+	}
+}
+
+func Test_webhookTLS(t *testing.T) {
+	certPEM, keyPEM, err := generateSelfSignedCert("bot.example.com", time.Now())
+	if err != nil {
+		t.Fatalf("generateSelfSignedCert() error = %v", err)
+	}
+	dir, err := ioutil.TempDir("", "ubot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	certFile, keyFile := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	ioutil.WriteFile(certFile, certPEM, 0600)
+	ioutil.WriteFile(keyFile, keyPEM, 0600)
+
+	tests := []struct {
+		name          string
+		configuration Configuration
+		wantTLS       bool
+		wantUpload    bool
+		wantErr       bool
+	}{
+		{
+			name:          "plain http",
+			configuration: Configuration{},
+		},
+		{
+			name:          "certificate files",
+			configuration: Configuration{WebhookCertFile: certFile, WebhookKeyFile: keyFile},
+			wantTLS:       true,
+		},
+		{
+			name:          "uploaded certificate files",
+			configuration: Configuration{WebhookCertFile: certFile, WebhookKeyFile: keyFile, WebhookUploadCert: true},
+			wantTLS:       true,
+			wantUpload:    true,
+		},
+		{
+			name:          "self-signed",
+			configuration: Configuration{WebhookSelfSignedHost: "bot.example.com"},
+			wantTLS:       true,
+			wantUpload:    true,
+		},
+		{
+			name:          "missing key",
+			configuration: Configuration{WebhookCertFile: certFile, WebhookKeyFile: filepath.Join(dir, "missing.pem")},
+			wantErr:       true,
+		},
+		{
+			name:          "mismatched key",
+			configuration: Configuration{WebhookCertFile: certFile, WebhookKeyFile: certFile},
+			wantErr:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tlsConfig, upload, err := webhookTLS(tt.configuration)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("webhookTLS() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if (tlsConfig != nil) != tt.wantTLS {
+				t.Errorf("webhookTLS() tlsConfig = %v, want TLS %v", tlsConfig, tt.wantTLS)
+			}
+			if (upload != nil) != tt.wantUpload {
+				t.Errorf("webhookTLS() upload = %v, want upload %v", upload != nil, tt.wantUpload)
+			}
+		})
+	}
+}
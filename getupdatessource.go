@@ -2,57 +2,121 @@ package ubot
 
 import (
 	"context"
-	"log"
-	"strconv"
+	"errors"
+	"fmt"
+	"time"
 
 	"github.com/sdurz/axon"
 )
 
+// DefaultPollTimeout is the default long polling timeout of GetUpdatesSource, in seconds
+const DefaultPollTimeout = 30
+
+// pollGrace is added to the poll timeout to get the timeout of a getUpdates request,
+// so that the request isn't cancelled before the API server answers.
+var pollGrace = 10 * time.Second
+
+// pollMinBackoff and pollMaxBackoff bound the exponential delay between failed getUpdates requests
+var (
+	pollMinBackoff = time.Second
+	pollMaxBackoff = time.Minute
+)
+
+// pollSleep waits for d, or until ctx is done
+var pollSleep = func(ctx context.Context, d time.Duration) {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-ctx.Done():
+	}
+}
+
 // GetUpdatesSource is a ServerSource tha get updates vi long polling
+// The polling is configured by PollTimeout, PollLimit and AllowedUpdates in the bot Configuration.
+// After a failed request polling resumes with an exponential backoff.
 // See https://core.telegram.org/bots/api#getupdates
 func GetUpdatesSource(bot *Bot, ctx context.Context, updatesChan chan axon.O) {
-	var nextUpdate int64 = 0
-	var ok bool
+	pollTimeout := bot.Configuration.PollTimeout
+	if pollTimeout <= 0 {
+		pollTimeout = DefaultPollTimeout
+	}
+	request := axon.O{
+		"timeout": int64(pollTimeout),
+	}
+	if bot.Configuration.PollLimit > 0 {
+		request["limit"] = int64(bot.Configuration.PollLimit)
+	}
+	if len(bot.Configuration.AllowedUpdates) > 0 {
+		request["allowed_updates"] = bot.Configuration.AllowedUpdates
+	}
+
+	var (
+		nextUpdate int64 = 0
+		backoff    time.Duration
+		ok         bool
+	)
 	for {
-		select {
-		case <-ctx.Done():
-			log.Println("done with getUpdatesSource")
+		if backoff > 0 {
+			pollSleep(ctx, backoff)
+		}
+		if ctx.Err() != nil {
+			bot.log().Log(LevelInfo, "done with getUpdatesSource")
 			return
-		default:
-			getURL := bot.methodURL("getUpdates") + "?offset=" + strconv.FormatInt(nextUpdate, 10)
-			var responseUpdates interface{}
-			responseUpdates, err := bot.apiClient.GetJson(getURL)
-			if err != nil {
-				log.Println("Error while retrieving updates", err)
-				continue
-			}
+		}
 
-			var updates axon.A
-			if updates, ok = responseUpdates.([]interface{}); !ok {
-				log.Fatalln("updates result not a JSON array")
+		request["offset"] = nextUpdate
+		requestCtx, cancel := context.WithTimeout(ctx, time.Duration(pollTimeout)*time.Second+pollGrace)
+		responseUpdates, err := bot.apiClient.PostJson(requestCtx, bot.methodURL("getUpdates"), request)
+		cancel()
+		if err != nil {
+			if ctx.Err() == nil {
+				backoff = nextPollBackoff(backoff)
+				bot.reportError(ctx, fmt.Errorf("error while retrieving updates, retrying in %v: %w", backoff, err))
 			}
+			continue
+		}
+
+		var updates axon.A
+		if updates, ok = responseUpdates.([]interface{}); !ok {
+			backoff = nextPollBackoff(backoff)
+			bot.reportError(ctx, errors.New("updates result not a JSON array"))
+			continue
+		}
+		backoff = 0
 
-			if len(updates) > 0 {
-				for _, update := range updates {
-					var (
-						updateID int64
-						oUpdate  axon.O
-					)
-					if oUpdate, ok = update.(map[string]interface{}); !ok {
-						log.Println("update not an axon.O")
-						continue
-					}
-					if updateID, err = oUpdate.GetInteger("update_id"); err != nil {
-						log.Println("update does not have an integer id")
-						continue
-					}
-					if updateID > nextUpdate {
-						nextUpdate = updateID
-					}
-					updatesChan <- oUpdate
+		if len(updates) > 0 {
+			for _, update := range updates {
+				var (
+					updateID int64
+					oUpdate  axon.O
+				)
+				if oUpdate, ok = update.(map[string]interface{}); !ok {
+					bot.reportError(ctx, errors.New("update not an axon.O"))
+					continue
 				}
-				nextUpdate++
+				if updateID, err = oUpdate.GetInteger("update_id"); err != nil {
+					bot.reportError(ctx, errors.New("update does not have an integer id"))
+					continue
+				}
+				if updateID > nextUpdate {
+					nextUpdate = updateID
+				}
+				updatesChan <- oUpdate
 			}
+			nextUpdate++
 		}
 	}
 }
+
+// nextPollBackoff doubles backoff within pollMinBackoff and pollMaxBackoff
+func nextPollBackoff(backoff time.Duration) time.Duration {
+	backoff *= 2
+	if backoff < pollMinBackoff {
+		backoff = pollMinBackoff
+	}
+	if backoff > pollMaxBackoff {
+		backoff = pollMaxBackoff
+	}
+	return backoff
+}
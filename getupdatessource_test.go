@@ -0,0 +1,180 @@
+package ubot
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sdurz/axon"
+)
+
+func TestGetUpdatesSource_errors(t *testing.T) {
+	tests := []struct {
+		name      string
+		apiClient apiClient
+	}{
+		{
+			name:      "network error",
+			apiClient: &failingAPIClient{err: errors.New("dns glitch")},
+		},
+		{
+			name: "result not an array",
+			apiClient: &mockAPIClient{
+				method: "getUpdates",
+				interfaceMethod: func() interface{} {
+					return map[string]interface{}{}
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				mutex    sync.Mutex
+				reported int
+			)
+			b := &Bot{apiClient: tt.apiClient}
+			b.SetErrorHandler(func(ctx context.Context, bot *Bot, err error) {
+				mutex.Lock()
+				reported++
+				mutex.Unlock()
+			})
+
+			ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+			defer cancel()
+			done := make(chan struct{})
+			go func() {
+				GetUpdatesSource(b, ctx, make(chan axon.O))
+				close(done)
+			}()
+			select {
+			case <-done:
+			case <-time.After(time.Second):
+				t.Fatalf("GetUpdatesSource() didn't stop")
+			}
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			if reported == 0 {
+				t.Errorf("GetUpdatesSource() reported no errors")
+			}
+		})
+	}
+}
+
+func TestGetUpdatesSource_request(t *testing.T) {
+	tests := []struct {
+		name          string
+		configuration Configuration
+		wantRequest   axon.O
+	}{
+		{
+			name:          "defaults",
+			configuration: Configuration{},
+			wantRequest: axon.O{
+				"offset":  int64(8),
+				"timeout": int64(DefaultPollTimeout),
+			},
+		},
+		{
+			name: "configured",
+			configuration: Configuration{
+				PollTimeout:    50,
+				PollLimit:      10,
+				AllowedUpdates: []string{"message", "callback_query"},
+			},
+			wantRequest: axon.O{
+				"offset":          int64(8),
+				"timeout":         int64(50),
+				"limit":           int64(10),
+				"allowed_updates": []string{"message", "callback_query"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			calls := 0
+			client := &recordingAPIClient{
+				mockAPIClient: mockAPIClient{
+					method: "getUpdates",
+					interfaceMethod: func() interface{} {
+						calls++
+						if calls > 1 {
+							// stop after the request following the first update
+							cancel()
+							return []interface{}{}
+						}
+						return []interface{}{
+							map[string]interface{}{"update_id": 7.},
+						}
+					},
+				},
+			}
+			b := &Bot{Configuration: tt.configuration, apiClient: client}
+
+			updatesChan := make(chan axon.O, 1)
+			GetUpdatesSource(b, ctx, updatesChan)
+
+			if !reflect.DeepEqual(client.request, tt.wantRequest) {
+				t.Errorf("GetUpdatesSource() request = %v, want %v", client.request, tt.wantRequest)
+			}
+		})
+	}
+}
+
+// scriptedAPIClient answers getUpdates with the results of a script of errors,
+// an update is returned for a nil error
+type scriptedAPIClient struct {
+	failingAPIClient
+	script []error
+	calls  int
+}
+
+func (s *scriptedAPIClient) PostJson(ctx context.Context, URL string, request interface{}) (result interface{}, err error) {
+	if s.calls < len(s.script) {
+		err = s.script[s.calls]
+	} else {
+		err = errors.New("script over")
+	}
+	s.calls++
+	if err == nil {
+		result = []interface{}{map[string]interface{}{"update_id": float64(s.calls)}}
+	}
+	return
+}
+
+func TestGetUpdatesSource_backoff(t *testing.T) {
+	defer func(min, max time.Duration, sleep func(context.Context, time.Duration)) {
+		pollMinBackoff, pollMaxBackoff, pollSleep = min, max, sleep
+	}(pollMinBackoff, pollMaxBackoff, pollSleep)
+	pollMinBackoff, pollMaxBackoff = time.Second, 4*time.Second
+
+	networkDown := errors.New("network down")
+	client := &scriptedAPIClient{
+		script: []error{networkDown, networkDown, networkDown, networkDown, nil, networkDown},
+	}
+	b := &Bot{apiClient: client}
+	b.SetErrorHandler(func(ctx context.Context, bot *Bot, err error) {})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var delays []time.Duration
+	pollSleep = func(ctx context.Context, d time.Duration) {
+		delays = append(delays, d)
+		if len(delays) == 5 {
+			cancel()
+		}
+	}
+	GetUpdatesSource(b, ctx, make(chan axon.O, 1))
+
+	// delays double up to pollMaxBackoff and start over after a successful request
+	wantDelays := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 4 * time.Second, time.Second}
+	if !reflect.DeepEqual(delays, wantDelays) {
+		t.Errorf("GetUpdatesSource() delays = %v, want %v", delays, wantDelays)
+	}
+}
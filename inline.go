@@ -0,0 +1,300 @@
+package ubot
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/sdurz/axon"
+)
+
+// MaxInlineQueryResults is the maximum number of results allowed in an answer to an inline query
+const MaxInlineQueryResults = 50
+
+// The following builders return the required fields of the InlineQueryResult variants,
+// optional fields can be set on the returned axon.O.
+// see https://core.telegram.org/bots/api#inlinequeryresult
+
+// InputTextMessageContent returns the content of a text message to be sent as the result of an inline query.
+// see https://core.telegram.org/bots/api#inputtextmessagecontent
+func InputTextMessageContent(text string) axon.O {
+	return axon.O{
+		"message_text": text,
+	}
+}
+
+// InlineQueryResultArticle returns a link to an article or web page.
+// see https://core.telegram.org/bots/api#inlinequeryresultarticle
+func InlineQueryResultArticle(id, title string, inputMessageContent axon.O) axon.O {
+	return axon.O{
+		"type":                  "article",
+		"id":                    id,
+		"title":                 title,
+		"input_message_content": inputMessageContent,
+	}
+}
+
+// InlineQueryResultPhoto returns a link to a photo.
+// see https://core.telegram.org/bots/api#inlinequeryresultphoto
+func InlineQueryResultPhoto(id, photoURL, thumbnailURL string) axon.O {
+	return axon.O{
+		"type":          "photo",
+		"id":            id,
+		"photo_url":     photoURL,
+		"thumbnail_url": thumbnailURL,
+	}
+}
+
+// InlineQueryResultGif returns a link to an animated GIF file.
+// see https://core.telegram.org/bots/api#inlinequeryresultgif
+func InlineQueryResultGif(id, gifURL, thumbnailURL string) axon.O {
+	return axon.O{
+		"type":          "gif",
+		"id":            id,
+		"gif_url":       gifURL,
+		"thumbnail_url": thumbnailURL,
+	}
+}
+
+// InlineQueryResultMpeg4Gif returns a link to a video animation (H.264/MPEG-4 AVC video without sound).
+// see https://core.telegram.org/bots/api#inlinequeryresultmpeg4gif
+func InlineQueryResultMpeg4Gif(id, mpeg4URL, thumbnailURL string) axon.O {
+	return axon.O{
+		"type":          "mpeg4_gif",
+		"id":            id,
+		"mpeg4_url":     mpeg4URL,
+		"thumbnail_url": thumbnailURL,
+	}
+}
+
+// InlineQueryResultVideo returns a link to a page containing an embedded video player or a video file.
+// see https://core.telegram.org/bots/api#inlinequeryresultvideo
+func InlineQueryResultVideo(id, videoURL, mimeType, thumbnailURL, title string) axon.O {
+	return axon.O{
+		"type":          "video",
+		"id":            id,
+		"video_url":     videoURL,
+		"mime_type":     mimeType,
+		"thumbnail_url": thumbnailURL,
+		"title":         title,
+	}
+}
+
+// InlineQueryResultAudio returns a link to an MP3 audio file.
+// see https://core.telegram.org/bots/api#inlinequeryresultaudio
+func InlineQueryResultAudio(id, audioURL, title string) axon.O {
+	return axon.O{
+		"type":      "audio",
+		"id":        id,
+		"audio_url": audioURL,
+		"title":     title,
+	}
+}
+
+// InlineQueryResultVoice returns a link to a voice recording in an .OGG container encoded with OPUS.
+// see https://core.telegram.org/bots/api#inlinequeryresultvoice
+func InlineQueryResultVoice(id, voiceURL, title string) axon.O {
+	return axon.O{
+		"type":      "voice",
+		"id":        id,
+		"voice_url": voiceURL,
+		"title":     title,
+	}
+}
+
+// InlineQueryResultDocument returns a link to a file, only .PDF and .ZIP files can be sent this way.
+// see https://core.telegram.org/bots/api#inlinequeryresultdocument
+func InlineQueryResultDocument(id, title, documentURL, mimeType string) axon.O {
+	return axon.O{
+		"type":         "document",
+		"id":           id,
+		"title":        title,
+		"document_url": documentURL,
+		"mime_type":    mimeType,
+	}
+}
+
+// InlineQueryResultLocation returns a location on a map.
+// see https://core.telegram.org/bots/api#inlinequeryresultlocation
+func InlineQueryResultLocation(id string, latitude, longitude float64, title string) axon.O {
+	return axon.O{
+		"type":      "location",
+		"id":        id,
+		"latitude":  latitude,
+		"longitude": longitude,
+		"title":     title,
+	}
+}
+
+// InlineQueryResultVenue returns a venue.
+// see https://core.telegram.org/bots/api#inlinequeryresultvenue
+func InlineQueryResultVenue(id string, latitude, longitude float64, title, address string) axon.O {
+	return axon.O{
+		"type":      "venue",
+		"id":        id,
+		"latitude":  latitude,
+		"longitude": longitude,
+		"title":     title,
+		"address":   address,
+	}
+}
+
+// InlineQueryResultContact returns a contact with a phone number.
+// see https://core.telegram.org/bots/api#inlinequeryresultcontact
+func InlineQueryResultContact(id, phoneNumber, firstName string) axon.O {
+	return axon.O{
+		"type":         "contact",
+		"id":           id,
+		"phone_number": phoneNumber,
+		"first_name":   firstName,
+	}
+}
+
+// InlineQueryResultGame returns a game.
+// see https://core.telegram.org/bots/api#inlinequeryresultgame
+func InlineQueryResultGame(id, gameShortName string) axon.O {
+	return axon.O{
+		"type":            "game",
+		"id":              id,
+		"game_short_name": gameShortName,
+	}
+}
+
+// InlineQueryResultCachedPhoto returns a photo stored on the Telegram servers.
+// see https://core.telegram.org/bots/api#inlinequeryresultcachedphoto
+func InlineQueryResultCachedPhoto(id, photoFileID string) axon.O {
+	return axon.O{
+		"type":          "photo",
+		"id":            id,
+		"photo_file_id": photoFileID,
+	}
+}
+
+// InlineQueryResultCachedGif returns an animated GIF file stored on the Telegram servers.
+// see https://core.telegram.org/bots/api#inlinequeryresultcachedgif
+func InlineQueryResultCachedGif(id, gifFileID string) axon.O {
+	return axon.O{
+		"type":        "gif",
+		"id":          id,
+		"gif_file_id": gifFileID,
+	}
+}
+
+// InlineQueryResultCachedMpeg4Gif returns a video animation stored on the Telegram servers.
+// see https://core.telegram.org/bots/api#inlinequeryresultcachedmpeg4gif
+func InlineQueryResultCachedMpeg4Gif(id, mpeg4FileID string) axon.O {
+	return axon.O{
+		"type":          "mpeg4_gif",
+		"id":            id,
+		"mpeg4_file_id": mpeg4FileID,
+	}
+}
+
+// InlineQueryResultCachedSticker returns a sticker stored on the Telegram servers.
+// see https://core.telegram.org/bots/api#inlinequeryresultcachedsticker
+func InlineQueryResultCachedSticker(id, stickerFileID string) axon.O {
+	return axon.O{
+		"type":            "sticker",
+		"id":              id,
+		"sticker_file_id": stickerFileID,
+	}
+}
+
+// InlineQueryResultCachedDocument returns a file stored on the Telegram servers.
+// see https://core.telegram.org/bots/api#inlinequeryresultcacheddocument
+func InlineQueryResultCachedDocument(id, title, documentFileID string) axon.O {
+	return axon.O{
+		"type":             "document",
+		"id":               id,
+		"title":            title,
+		"document_file_id": documentFileID,
+	}
+}
+
+// InlineQueryResultCachedVideo returns a video file stored on the Telegram servers.
+// see https://core.telegram.org/bots/api#inlinequeryresultcachedvideo
+func InlineQueryResultCachedVideo(id, videoFileID, title string) axon.O {
+	return axon.O{
+		"type":          "video",
+		"id":            id,
+		"video_file_id": videoFileID,
+		"title":         title,
+	}
+}
+
+// InlineQueryResultCachedVoice returns a voice message stored on the Telegram servers.
+// see https://core.telegram.org/bots/api#inlinequeryresultcachedvoice
+func InlineQueryResultCachedVoice(id, voiceFileID, title string) axon.O {
+	return axon.O{
+		"type":          "voice",
+		"id":            id,
+		"voice_file_id": voiceFileID,
+		"title":         title,
+	}
+}
+
+// InlineQueryResultCachedAudio returns an MP3 audio file stored on the Telegram servers.
+// see https://core.telegram.org/bots/api#inlinequeryresultcachedaudio
+func InlineQueryResultCachedAudio(id, audioFileID string) axon.O {
+	return axon.O{
+		"type":          "audio",
+		"id":            id,
+		"audio_file_id": audioFileID,
+	}
+}
+
+// PaginateInlineResults slices the page of results that starts at offset,
+// the offset of an inline query as sent by the client ("" for the first page).
+// It returns the page and the next_offset for the answer, empty when there are no more results.
+// pageSize is capped to MaxInlineQueryResults.
+func PaginateInlineResults(results axon.A, offset string, pageSize int) (page axon.A, nextOffset string, err error) {
+	if pageSize <= 0 || pageSize > MaxInlineQueryResults {
+		pageSize = MaxInlineQueryResults
+	}
+	start := 0
+	if offset != "" {
+		if start, err = strconv.Atoi(offset); err != nil || start < 0 {
+			err = fmt.Errorf("invalid inline query offset %q", offset)
+			return
+		}
+	}
+	if start >= len(results) {
+		page = axon.A{}
+		return
+	}
+	end := start + pageSize
+	if end < len(results) {
+		nextOffset = strconv.Itoa(end)
+	} else {
+		end = len(results)
+	}
+	page = results[start:end]
+	return
+}
+
+// AnswerInlineQueryPage answers inlineQuery with the page of results requested by its offset.
+// Additional answer fields, such as cache_time, can be passed in request.
+func (b *Bot) AnswerInlineQueryPage(ctx context.Context, inlineQuery axon.O, results axon.A, pageSize int, request axon.O) (result bool, err error) {
+	var (
+		queryID    string
+		offset     string
+		page       axon.A
+		nextOffset string
+	)
+	if queryID, err = inlineQuery.GetString("id"); err != nil {
+		return
+	}
+	offset, _ = inlineQuery.GetString("offset")
+	if page, nextOffset, err = PaginateInlineResults(results, offset, pageSize); err != nil {
+		return
+	}
+
+	answer := axon.O{}
+	for key, value := range request {
+		answer[key] = value
+	}
+	answer["inline_query_id"] = queryID
+	answer["results"] = page
+	answer["next_offset"] = nextOffset
+	return b.AnswerInlineQuery(ctx, answer)
+}
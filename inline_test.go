@@ -0,0 +1,114 @@
+package ubot
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/sdurz/axon"
+)
+
+func Test_InlineQueryResultArticle(t *testing.T) {
+	got := InlineQueryResultArticle("1", "title", InputTextMessageContent("text"))
+	want := axon.O{
+		"type":  "article",
+		"id":    "1",
+		"title": "title",
+		"input_message_content": axon.O{
+			"message_text": "text",
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("InlineQueryResultArticle() = %v, want %v", got, want)
+	}
+}
+
+func Test_PaginateInlineResults(t *testing.T) {
+	results := axon.A{}
+	for i := 0; i < 120; i++ {
+		results = append(results, i)
+	}
+	tests := []struct {
+		name           string
+		offset         string
+		pageSize       int
+		wantPage       axon.A
+		wantNextOffset string
+		wantErr        bool
+	}{
+		{
+			name:           "first page",
+			offset:         "",
+			pageSize:       50,
+			wantPage:       results[0:50],
+			wantNextOffset: "50",
+		},
+		{
+			name:           "oversized page",
+			offset:         "50",
+			pageSize:       1000,
+			wantPage:       results[50:100],
+			wantNextOffset: "100",
+		},
+		{
+			name:           "last page",
+			offset:         "100",
+			pageSize:       50,
+			wantPage:       results[100:120],
+			wantNextOffset: "",
+		},
+		{
+			name:           "past the end",
+			offset:         "150",
+			pageSize:       50,
+			wantPage:       axon.A{},
+			wantNextOffset: "",
+		},
+		{
+			name:    "invalid offset",
+			offset:  "abc",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotPage, gotNextOffset, err := PaginateInlineResults(results, tt.offset, tt.pageSize)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PaginateInlineResults() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(gotPage, tt.wantPage) {
+				t.Errorf("PaginateInlineResults() page = %v, want %v", gotPage, tt.wantPage)
+			}
+			if gotNextOffset != tt.wantNextOffset {
+				t.Errorf("PaginateInlineResults() nextOffset = %v, want %v", gotNextOffset, tt.wantNextOffset)
+			}
+		})
+	}
+}
+
+func TestBot_AnswerInlineQueryPage(t *testing.T) {
+	client := &recordingAPIClient{
+		mockAPIClient: mockAPIClient{
+			method: "answerInlineQuery",
+			interfaceMethod: func() interface{} {
+				return true
+			},
+		},
+	}
+	b := &Bot{apiClient: client}
+	results := axon.A{1, 2, 3, 4, 5}
+	inlineQuery := axon.O{"id": "query", "offset": "2"}
+	if _, err := b.AnswerInlineQueryPage(context.Background(), inlineQuery, results, 2, axon.O{"cache_time": 10}); err != nil {
+		t.Fatalf("Bot.AnswerInlineQueryPage() error = %v", err)
+	}
+	want := axon.O{
+		"inline_query_id": "query",
+		"results":         axon.A{3, 4},
+		"next_offset":     "4",
+		"cache_time":      10,
+	}
+	if !reflect.DeepEqual(client.request, want) {
+		t.Errorf("Bot.AnswerInlineQueryPage() request = %v, want %v", client.request, want)
+	}
+}
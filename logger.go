@@ -0,0 +1,120 @@
+package ubot
+
+import (
+	"fmt"
+	"log"
+	"strings"
+)
+
+// LogLevel is the severity of a log entry
+type LogLevel int
+
+// Log levels, from the most verbose
+const (
+	LevelDebug LogLevel = iota
+	LevelInfo
+	LevelWarn
+	LevelError
+)
+
+func (l LogLevel) String() string {
+	switch l {
+	case LevelDebug:
+		return "DEBUG"
+	case LevelInfo:
+		return "INFO"
+	case LevelWarn:
+		return "WARN"
+	case LevelError:
+		return "ERROR"
+	default:
+		return fmt.Sprintf("LEVEL(%d)", int(l))
+	}
+}
+
+// Logger is the interface ubot writes its logs to.
+// keyvals are alternating keys and values, as in log/slog.
+type Logger interface {
+	Log(level LogLevel, msg string, keyvals ...interface{})
+}
+
+// NopLogger discards every log entry, it's the default Logger.
+type NopLogger struct{}
+
+// Log implements Logger
+func (NopLogger) Log(level LogLevel, msg string, keyvals ...interface{}) {}
+
+// stdLogger adapts a *log.Logger to Logger
+type stdLogger struct {
+	logger   *log.Logger
+	minLevel LogLevel
+}
+
+// NewStdLogger returns a Logger that writes to logger the entries at minLevel or above.
+func NewStdLogger(logger *log.Logger, minLevel LogLevel) Logger {
+	return &stdLogger{logger: logger, minLevel: minLevel}
+}
+
+// Log implements Logger
+func (s *stdLogger) Log(level LogLevel, msg string, keyvals ...interface{}) {
+	if level < s.minLevel {
+		return
+	}
+	var builder strings.Builder
+	builder.WriteString(level.String())
+	builder.WriteString(" ")
+	builder.WriteString(msg)
+	for i := 0; i < len(keyvals); i += 2 {
+		builder.WriteString(" ")
+		if i+1 < len(keyvals) {
+			fmt.Fprintf(&builder, "%v=%v", keyvals[i], keyvals[i+1])
+		} else {
+			fmt.Fprintf(&builder, "%v", keyvals[i])
+		}
+	}
+	s.logger.Println(builder.String())
+}
+
+// redactingLogger removes the API token from the entries it forwards,
+// so that request URLs and errors wrapping them can be logged safely.
+type redactingLogger struct {
+	logger Logger
+	token  string
+}
+
+func newRedactingLogger(logger Logger, token string) Logger {
+	if logger == nil {
+		logger = NopLogger{}
+	}
+	return &redactingLogger{logger: logger, token: token}
+}
+
+// Log implements Logger
+func (r *redactingLogger) Log(level LogLevel, msg string, keyvals ...interface{}) {
+	redacted := make([]interface{}, len(keyvals))
+	for i, value := range keyvals {
+		switch v := value.(type) {
+		case string:
+			redacted[i] = r.redact(v)
+		case error:
+			redacted[i] = r.redact(v.Error())
+		case fmt.Stringer:
+			redacted[i] = r.redact(v.String())
+		default:
+			redacted[i] = value
+		}
+	}
+	r.logger.Log(level, r.redact(msg), redacted...)
+}
+
+func (r *redactingLogger) redact(s string) string {
+	return redactToken(s, r.token)
+}
+
+// redactToken replaces token in s
+func redactToken(s, token string) string {
+	if token == "" {
+		return s
+	}
+	return strings.ReplaceAll(s, token, "<redacted>")
+}
@@ -0,0 +1,35 @@
+//go:build go1.21
+// +build go1.21
+
+package ubot
+
+import (
+	"context"
+	"log/slog"
+)
+
+// slogLogger adapts a *slog.Logger to Logger
+type slogLogger struct {
+	logger *slog.Logger
+}
+
+// NewSlogLogger returns a Logger that writes to a *slog.Logger.
+func NewSlogLogger(logger *slog.Logger) Logger {
+	return &slogLogger{logger: logger}
+}
+
+// Log implements Logger
+func (s *slogLogger) Log(level LogLevel, msg string, keyvals ...interface{}) {
+	var slogLevel slog.Level
+	switch level {
+	case LevelDebug:
+		slogLevel = slog.LevelDebug
+	case LevelInfo:
+		slogLevel = slog.LevelInfo
+	case LevelWarn:
+		slogLevel = slog.LevelWarn
+	default:
+		slogLevel = slog.LevelError
+	}
+	s.logger.Log(context.Background(), slogLevel, msg, keyvals...)
+}
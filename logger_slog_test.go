@@ -0,0 +1,29 @@
+//go:build go1.21
+// +build go1.21
+
+package ubot
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+)
+
+func Test_slogLogger(t *testing.T) {
+	var buffer bytes.Buffer
+	handler := slog.NewTextHandler(&buffer, &slog.HandlerOptions{
+		Level: slog.LevelInfo,
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	})
+	logger := NewSlogLogger(slog.New(handler))
+	logger.Log(LevelDebug, "not logged")
+	logger.Log(LevelWarn, "logged", "key", "value")
+	if got, want := buffer.String(), "level=WARN msg=logged key=value\n"; got != want {
+		t.Errorf("slogLogger.Log() = %q, want %q", got, want)
+	}
+}
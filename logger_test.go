@@ -0,0 +1,39 @@
+package ubot
+
+import (
+	"bytes"
+	"errors"
+	"log"
+	"strings"
+	"testing"
+)
+
+func Test_redactingLogger(t *testing.T) {
+	var buffer bytes.Buffer
+	logger := newRedactingLogger(NewStdLogger(log.New(&buffer, "", 0), LevelDebug), "123:abc")
+
+	logger.Log(LevelDebug, "get request 123:abc",
+		"url", "https://api.telegram.org/bot123:abc/getMe",
+		"error", errors.New(`Post "https://api.telegram.org/bot123:abc/sendMessage": dial tcp: lookup failed`),
+		"size", 10,
+	)
+	got := buffer.String()
+	if strings.Contains(got, "123:abc") {
+		t.Errorf("redactingLogger.Log() leaked the token: %v", got)
+	}
+	want := "DEBUG get request <redacted> url=https://api.telegram.org/bot<redacted>/getMe " +
+		`error=Post "https://api.telegram.org/bot<redacted>/sendMessage": dial tcp: lookup failed size=10` + "\n"
+	if got != want {
+		t.Errorf("redactingLogger.Log() = %q, want %q", got, want)
+	}
+}
+
+func Test_stdLogger_level(t *testing.T) {
+	var buffer bytes.Buffer
+	logger := NewStdLogger(log.New(&buffer, "", 0), LevelWarn)
+	logger.Log(LevelInfo, "not logged")
+	logger.Log(LevelError, "logged", "key", "value")
+	if got, want := buffer.String(), "ERROR logged key=value\n"; got != want {
+		t.Errorf("stdLogger.Log() = %q, want %q", got, want)
+	}
+}
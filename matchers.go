@@ -1,8 +1,6 @@
 package ubot
 
 import (
-	"log"
-
 	"github.com/sdurz/axon"
 )
 
@@ -67,6 +65,26 @@ func IsFrom(userID int64) Matcher {
 	}
 }
 
+// MessageInThread matches messages sent to a message thread, such as a forum topic
+func MessageInThread(threadID int64) Matcher {
+	return func(bot *Bot, message axon.O) (result bool) {
+		var (
+			err       error
+			iThreadID int64
+		)
+		if iThreadID, err = message.GetInteger("message_thread_id"); err == nil {
+			result = iThreadID == threadID
+		}
+		return
+	}
+}
+
+// MessageIsTopicMessage matches messages sent to a forum topic
+func MessageIsTopicMessage(bot *Bot, message axon.O) (result bool) {
+	result, _ = message.GetBoolean("is_topic_message")
+	return
+}
+
 // MessageHasPhoto matches if updates has axon.A photo
 func MessageHasPhoto(bot *Bot, message axon.O) (result bool) {
 	if _, err := message.GetArray("photo"); err == nil {
@@ -75,6 +93,22 @@ func MessageHasPhoto(bot *Bot, message axon.O) (result bool) {
 	return
 }
 
+// MessageHasSuccessfulPayment matches service messages about a successful payment
+func MessageHasSuccessfulPayment(bot *Bot, message axon.O) (result bool) {
+	if _, err := message.GetObject("successful_payment"); err == nil {
+		result = true
+	}
+	return
+}
+
+// MessageHasWebAppData matches service messages carrying data sent by a Web App
+func MessageHasWebAppData(bot *Bot, message axon.O) (result bool) {
+	if _, err := message.GetObject("web_app_data"); err == nil {
+		result = true
+	}
+	return
+}
+
 // MatchMessageEntities matches if update has message entities
 func MessageHasEntities(bot *Bot, message axon.O) (result bool) {
 	if _, err := message.GetArray("entities"); err == nil {
@@ -115,6 +149,26 @@ func MessageInGroup(bot *Bot, message axon.O) (result bool) {
 	return
 }
 
+// GameShortName returns the game_short_name of a callback query sent from a game
+func GameShortName(callbackQuery axon.O) (result string, ok bool) {
+	var err error
+	if result, err = callbackQuery.GetString("game_short_name"); err == nil {
+		ok = true
+	}
+	return
+}
+
+// CallbackQueryIsGame matches callback queries for the given game, an empty gameShortName matches any game
+func CallbackQueryIsGame(gameShortName string) Matcher {
+	return func(bot *Bot, callbackQuery axon.O) (result bool) {
+		var name string
+		if name, result = GameShortName(callbackQuery); result && gameShortName != "" {
+			result = name == gameShortName
+		}
+		return
+	}
+}
+
 // MessageHasCommand matches if update has axon.A certain message entity
 func MessageHasCommand(entity string) func(bot *Bot, message axon.O) (result bool) {
 	return func(b *Bot, message axon.O) (result bool) {
@@ -150,7 +204,7 @@ func MessageHasCommand(entity string) func(bot *Bot, message axon.O) (result boo
 				oNtt   axon.O
 			)
 			if oNtt, ok = ntt.(map[string]interface{}); !ok {
-				log.Println("MessageHasCommand: entity not an axon.O")
+				b.log().Log(LevelWarn, "MessageHasCommand: entity not an axon.O")
 				return
 			}
 			if offset, err = oNtt.GetInteger("offset"); err != nil {
@@ -172,3 +226,93 @@ func MessageHasCommand(entity string) func(bot *Bot, message axon.O) (result boo
 		return
 	}
 }
+
+// ReactionChanges diffs old_reaction and new_reaction of a message_reaction update,
+// returning the reactions that were added and the ones that were removed.
+func ReactionChanges(messageReaction axon.O) (added axon.A, removed axon.A) {
+	oldReactions, _ := messageReaction.GetArray("old_reaction")
+	newReactions, _ := messageReaction.GetArray("new_reaction")
+	added = reactionsDifference(newReactions, oldReactions)
+	removed = reactionsDifference(oldReactions, newReactions)
+	return
+}
+
+// reactionKey identifies a ReactionType by its type and emoji or custom emoji id
+func reactionKey(reaction interface{}) (result string) {
+	var oReaction axon.O
+	oReaction, _ = reaction.(map[string]interface{})
+	reactionType, _ := oReaction.GetString("type")
+	switch reactionType {
+	case "emoji":
+		emoji, _ := oReaction.GetString("emoji")
+		result = "emoji:" + emoji
+	case "custom_emoji":
+		customEmojiID, _ := oReaction.GetString("custom_emoji_id")
+		result = "custom_emoji:" + customEmojiID
+	default:
+		result = reactionType
+	}
+	return
+}
+
+// reactionsDifference returns the reactions in a that are not in b
+func reactionsDifference(a, b axon.A) (result axon.A) {
+	keys := map[string]bool{}
+	for _, reaction := range b {
+		keys[reactionKey(reaction)] = true
+	}
+	for _, reaction := range a {
+		if !keys[reactionKey(reaction)] {
+			result = append(result, reaction)
+		}
+	}
+	return
+}
+
+// reactionChanged matches message_reaction updates where the reaction with the given key was added or removed
+func reactionChanged(key string, wantAdded bool) Matcher {
+	return func(bot *Bot, messageReaction axon.O) (result bool) {
+		added, removed := ReactionChanges(messageReaction)
+		changed := removed
+		if wantAdded {
+			changed = added
+		}
+		for _, reaction := range changed {
+			if reactionKey(reaction) == key {
+				return true
+			}
+		}
+		return
+	}
+}
+
+// ReactionAdded matches message_reaction updates where the emoji reaction was added
+func ReactionAdded(emoji string) Matcher {
+	return reactionChanged("emoji:"+emoji, true)
+}
+
+// ReactionRemoved matches message_reaction updates where the emoji reaction was removed
+func ReactionRemoved(emoji string) Matcher {
+	return reactionChanged("emoji:"+emoji, false)
+}
+
+// CustomEmojiReactionAdded matches message_reaction updates where the custom emoji reaction was added
+func CustomEmojiReactionAdded(customEmojiID string) Matcher {
+	return reactionChanged("custom_emoji:"+customEmojiID, true)
+}
+
+// CustomEmojiReactionRemoved matches message_reaction updates where the custom emoji reaction was removed
+func CustomEmojiReactionRemoved(customEmojiID string) Matcher {
+	return reactionChanged("custom_emoji:"+customEmojiID, false)
+}
+
+// ChatBoostFrom matches chat_boost and removed_chat_boost updates whose boost came from the given
+// source: BoostSourcePremium, BoostSourceGiftCode or BoostSourceGiveaway
+func ChatBoostFrom(source string) Matcher {
+	return func(bot *Bot, payload axon.O) (result bool) {
+		if kind, _, err := ChatBoostSource(payload); err == nil {
+			result = kind == source
+		}
+		return
+	}
+}
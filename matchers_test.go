@@ -361,3 +361,268 @@ func Test_MessageInGroup(t *testing.T) {
 		})
 	}
 }
+
+func Test_MessageHasSuccessfulPayment(t *testing.T) {
+	type args struct {
+		bot     *Bot
+		message axon.O
+	}
+	tests := []struct {
+		name       string
+		args       args
+		wantResult bool
+	}{
+		{
+			name: "successful payment",
+			args: args{
+				nil,
+				map[string]interface{}{
+					"successful_payment": map[string]interface{}{
+						"currency":        "EUR",
+						"total_amount":    1000.,
+						"invoice_payload": "order-1",
+					},
+				},
+			},
+			wantResult: true,
+		},
+		{
+			name: "plain message",
+			args: args{
+				nil,
+				map[string]interface{}{
+					"text": "hello",
+				},
+			},
+			wantResult: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if gotResult := MessageHasSuccessfulPayment(tt.args.bot, tt.args.message); gotResult != tt.wantResult {
+				t.Errorf("MessageHasSuccessfulPayment() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func Test_MessageHasWebAppData(t *testing.T) {
+	tests := []struct {
+		name       string
+		message    axon.O
+		wantResult bool
+	}{
+		{
+			name: "web app data",
+			message: map[string]interface{}{
+				"web_app_data": map[string]interface{}{
+					"data":        "{}",
+					"button_text": "Order",
+				},
+			},
+			wantResult: true,
+		},
+		{
+			name: "plain message",
+			message: map[string]interface{}{
+				"text": "hello",
+			},
+			wantResult: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if gotResult := MessageHasWebAppData(nil, tt.message); gotResult != tt.wantResult {
+				t.Errorf("MessageHasWebAppData() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func Test_MessageInThread(t *testing.T) {
+	tests := []struct {
+		name     string
+		threadID int64
+		message  axon.O
+		want     bool
+	}{
+		{
+			name:     "same topic",
+			threadID: 42,
+			message: map[string]interface{}{
+				"message_thread_id": 42.,
+				"is_topic_message":  true,
+			},
+			want: true,
+		},
+		{
+			name:     "other topic",
+			threadID: 42,
+			message: map[string]interface{}{
+				"message_thread_id": 43.,
+				"is_topic_message":  true,
+			},
+			want: false,
+		},
+		{
+			name:     "no topic",
+			threadID: 42,
+			message:  map[string]interface{}{},
+			want:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MessageInThread(tt.threadID)(nil, tt.message); got != tt.want {
+				t.Errorf("MessageInThread() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_MessageIsTopicMessage(t *testing.T) {
+	tests := []struct {
+		name    string
+		message axon.O
+		want    bool
+	}{
+		{
+			name: "topic message",
+			message: map[string]interface{}{
+				"message_thread_id": 42.,
+				"is_topic_message":  true,
+			},
+			want: true,
+		},
+		{
+			name: "reply thread",
+			message: map[string]interface{}{
+				"message_thread_id": 42.,
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MessageIsTopicMessage(nil, tt.message); got != tt.want {
+				t.Errorf("MessageIsTopicMessage() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_CallbackQueryIsGame(t *testing.T) {
+	tests := []struct {
+		name          string
+		gameShortName string
+		callbackQuery axon.O
+		want          bool
+	}{
+		{
+			name:          "any game",
+			gameShortName: "",
+			callbackQuery: map[string]interface{}{
+				"id":              "1",
+				"game_short_name": "tetris",
+			},
+			want: true,
+		},
+		{
+			name:          "same game",
+			gameShortName: "tetris",
+			callbackQuery: map[string]interface{}{
+				"id":              "1",
+				"game_short_name": "tetris",
+			},
+			want: true,
+		},
+		{
+			name:          "other game",
+			gameShortName: "pong",
+			callbackQuery: map[string]interface{}{
+				"id":              "1",
+				"game_short_name": "tetris",
+			},
+			want: false,
+		},
+		{
+			name:          "not a game",
+			gameShortName: "",
+			callbackQuery: map[string]interface{}{
+				"id":   "1",
+				"data": "button",
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CallbackQueryIsGame(tt.gameShortName)(nil, tt.callbackQuery); got != tt.want {
+				t.Errorf("CallbackQueryIsGame() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_ReactionMatchers(t *testing.T) {
+	messageReaction := axon.O{
+		"old_reaction": []interface{}{
+			map[string]interface{}{"type": "emoji", "emoji": "👍"},
+			map[string]interface{}{"type": "custom_emoji", "custom_emoji_id": "111"},
+		},
+		"new_reaction": []interface{}{
+			map[string]interface{}{"type": "emoji", "emoji": "🔥"},
+			map[string]interface{}{"type": "custom_emoji", "custom_emoji_id": "111"},
+			map[string]interface{}{"type": "custom_emoji", "custom_emoji_id": "222"},
+		},
+	}
+	tests := []struct {
+		name    string
+		matcher Matcher
+		want    bool
+	}{
+		{name: "emoji added", matcher: ReactionAdded("🔥"), want: true},
+		{name: "emoji not added", matcher: ReactionAdded("👍"), want: false},
+		{name: "emoji removed", matcher: ReactionRemoved("👍"), want: true},
+		{name: "emoji not removed", matcher: ReactionRemoved("🔥"), want: false},
+		{name: "custom emoji added", matcher: CustomEmojiReactionAdded("222"), want: true},
+		{name: "custom emoji kept", matcher: CustomEmojiReactionAdded("111"), want: false},
+		{name: "custom emoji not removed", matcher: CustomEmojiReactionRemoved("111"), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.matcher(nil, messageReaction); got != tt.want {
+				t.Errorf("matcher() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_ReactionChanges(t *testing.T) {
+	added, removed := ReactionChanges(axon.O{
+		"old_reaction": []interface{}{},
+		"new_reaction": []interface{}{
+			map[string]interface{}{"type": "emoji", "emoji": "🔥"},
+		},
+	})
+	if !reflect.DeepEqual(added, axon.A{map[string]interface{}{"type": "emoji", "emoji": "🔥"}}) {
+		t.Errorf("ReactionChanges() added = %v", added)
+	}
+	if len(removed) != 0 {
+		t.Errorf("ReactionChanges() removed = %v", removed)
+	}
+}
+
+func Test_ChatBoostFrom(t *testing.T) {
+	payload := axon.O{
+		"boost": map[string]interface{}{
+			"source": map[string]interface{}{"source": "gift_code"},
+		},
+	}
+	if !ChatBoostFrom(BoostSourceGiftCode)(nil, payload) {
+		t.Errorf("ChatBoostFrom(gift_code) = false, want true")
+	}
+	if ChatBoostFrom(BoostSourcePremium)(nil, payload) {
+		t.Errorf("ChatBoostFrom(premium) = true, want false")
+	}
+}
@@ -1,21 +1,35 @@
 package ubot
 
 import (
+	"context"
 	"errors"
 
 	"github.com/sdurz/axon"
 )
 
+// toMessageResult converts the result of methods that return either a Message or True
+func toMessageResult(response interface{}) (result MessageResult, err error) {
+	switch value := response.(type) {
+	case map[string]interface{}:
+		result.Message = value
+	case bool:
+		result.Inline = value
+	default:
+		err = errors.New("result is neither a Message nor True")
+	}
+	return
+}
+
 // GetMe returns basic information about the bot in form of a User object.
 // see https://core.telegram.org/bots/api#getme
-func (b *Bot) GetMe() (result *User, err error) {
+func (b *Bot) GetMe(ctx context.Context) (result *User, err error) {
 	var (
 		uResult User
 		iResult interface{}
 		oResult axon.O
 		ok      bool
 	)
-	if iResult, err = b.doGet("getMe"); err == nil {
+	if iResult, err = b.doGet(ctx, "getMe"); err == nil {
 		if oResult, ok = iResult.(map[string]interface{}); !ok {
 			err = errors.New("doGet returned unexpected type")
 			return
@@ -35,23 +49,23 @@ func (b *Bot) GetMe() (result *User, err error) {
 
 // LogOut logs the bot out of the cloud Bot API server
 // see https://core.telegram.org/bots/api#logout
-func (b *Bot) LogOut() (err error) {
-	_, err = b.doGet("logOut")
+func (b *Bot) LogOut(ctx context.Context) (err error) {
+	_, err = b.doGet(ctx, "logOut")
 	return
 }
 
 // Close closea the bot instance
 // see https://core.telegram.org/bots/api#close
-func (b *Bot) Close() (err error) {
-	_, err = b.doGet("close")
+func (b *Bot) Close(ctx context.Context) (err error) {
+	_, err = b.doGet(ctx, "close")
 	return
 }
 
 // SendMessage sends a text message
 // see https://core.telegram.org/bots/api#sendmessage
-func (b *Bot) SendMessage(request axon.O) (result axon.O, err error) {
+func (b *Bot) SendMessage(ctx context.Context, request axon.O) (result axon.O, err error) {
 	var response interface{}
-	if response, err = b.doPost("sendMessage", request); err == nil {
+	if response, err = b.doPost(ctx, "sendMessage", request); err == nil {
 		result = response.(map[string]interface{})
 	}
 	return
@@ -59,9 +73,9 @@ func (b *Bot) SendMessage(request axon.O) (result axon.O, err error) {
 
 // ForwardMessage forwards messages of any kind
 // see https://core.telegram.org/bots/api#forwardmessage
-func (b *Bot) ForwardMessage(request axon.O) (result axon.O, err error) {
+func (b *Bot) ForwardMessage(ctx context.Context, request axon.O) (result axon.O, err error) {
 	var response interface{}
-	if response, err = b.doPost("forwardMessage", request); err == nil {
+	if response, err = b.doPost(ctx, "forwardMessage", request); err == nil {
 		result = response.(map[string]interface{})
 	}
 	return
@@ -69,9 +83,9 @@ func (b *Bot) ForwardMessage(request axon.O) (result axon.O, err error) {
 
 // CopyMessage copy messages of any kind. The method is analogous to the method forwardMessage, but the copied message doesn't have a link to the original message.
 // see https://core.telegram.org/bots/api#copymessage
-func (b *Bot) CopyMessage(request axon.O) (result axon.O, err error) {
+func (b *Bot) CopyMessage(ctx context.Context, request axon.O) (result axon.O, err error) {
 	var response interface{}
-	if response, err = b.doPost("copyMessage", request); err == nil {
+	if response, err = b.doPost(ctx, "copyMessage", request); err == nil {
 		result = response.(map[string]interface{})
 	}
 	return
@@ -79,9 +93,9 @@ func (b *Bot) CopyMessage(request axon.O) (result axon.O, err error) {
 
 // SendPhoto sends a photo
 // see https://core.telegram.org/bots/api#sendphoto
-func (b *Bot) SendPhoto(request axon.O) (result axon.O, err error) {
+func (b *Bot) SendPhoto(ctx context.Context, request axon.O) (result axon.O, err error) {
 	var response interface{}
-	if response, err = b.doPostMultipart("sendPhoto", request); err == nil {
+	if response, err = b.doPostMultipart(ctx, "sendPhoto", request); err == nil {
 		result = response.(map[string]interface{})
 	}
 	return
@@ -89,9 +103,9 @@ func (b *Bot) SendPhoto(request axon.O) (result axon.O, err error) {
 
 // SendAudio sends an audio
 // see https://core.telegram.org/bots/api#sendaudio
-func (b *Bot) SendAudio(request axon.O) (result axon.O, err error) {
+func (b *Bot) SendAudio(ctx context.Context, request axon.O) (result axon.O, err error) {
 	var response interface{}
-	if response, err = b.doPostMultipart("sendAudio", request); err == nil {
+	if response, err = b.doPostMultipart(ctx, "sendAudio", request); err == nil {
 		result = response.(map[string]interface{})
 	}
 	return
@@ -99,9 +113,9 @@ func (b *Bot) SendAudio(request axon.O) (result axon.O, err error) {
 
 // SendVideo sends a video
 // see https://core.telegram.org/bots/api#senddocument
-func (b *Bot) SendDocument(request axon.O) (result axon.O, err error) {
+func (b *Bot) SendDocument(ctx context.Context, request axon.O) (result axon.O, err error) {
 	var response interface{}
-	if response, err = b.doPostMultipart("sendDocument", request); err == nil {
+	if response, err = b.doPostMultipart(ctx, "sendDocument", request); err == nil {
 		result = response.(map[string]interface{})
 	}
 	return
@@ -109,9 +123,9 @@ func (b *Bot) SendDocument(request axon.O) (result axon.O, err error) {
 
 // SendVideo sends a video
 // see https://core.telegram.org/bots/api#sendvideo
-func (b *Bot) SendVideo(request axon.O) (result axon.O, err error) {
+func (b *Bot) SendVideo(ctx context.Context, request axon.O) (result axon.O, err error) {
 	var response interface{}
-	if response, err = b.doPostMultipart("sendVideo", request); err == nil {
+	if response, err = b.doPostMultipart(ctx, "sendVideo", request); err == nil {
 		result = response.(map[string]interface{})
 	}
 	return
@@ -119,9 +133,9 @@ func (b *Bot) SendVideo(request axon.O) (result axon.O, err error) {
 
 // SendAnimation sends an animation
 // see https://core.telegram.org/bots/api#sendanimation
-func (b *Bot) SendAnimation(request axon.O) (result axon.O, err error) {
+func (b *Bot) SendAnimation(ctx context.Context, request axon.O) (result axon.O, err error) {
 	var response interface{}
-	if response, err = b.doPostMultipart("sendAnimation", request); err == nil {
+	if response, err = b.doPostMultipart(ctx, "sendAnimation", request); err == nil {
 		result = response.(map[string]interface{})
 	}
 	return
@@ -129,9 +143,9 @@ func (b *Bot) SendAnimation(request axon.O) (result axon.O, err error) {
 
 // SendVoice sends a voice
 // see https://core.telegram.org/bots/api#sendvoice
-func (b *Bot) SendVoice(request axon.O) (result axon.O, err error) {
+func (b *Bot) SendVoice(ctx context.Context, request axon.O) (result axon.O, err error) {
 	var response interface{}
-	if response, err = b.doPostMultipart("sendVoice", request); err == nil {
+	if response, err = b.doPostMultipart(ctx, "sendVoice", request); err == nil {
 		result = response.(map[string]interface{})
 	}
 	return
@@ -139,9 +153,9 @@ func (b *Bot) SendVoice(request axon.O) (result axon.O, err error) {
 
 // SendVoice sends a video note
 // see https://core.telegram.org/bots/api#sendvideonote
-func (b *Bot) SendVideoNote(request axon.O) (result axon.O, err error) {
+func (b *Bot) SendVideoNote(ctx context.Context, request axon.O) (result axon.O, err error) {
 	var response interface{}
-	if response, err = b.doPostMultipart("sendVideoNote", request); err == nil {
+	if response, err = b.doPostMultipart(ctx, "sendVideoNote", request); err == nil {
 		result = response.(map[string]interface{})
 	}
 	return
@@ -149,9 +163,9 @@ func (b *Bot) SendVideoNote(request axon.O) (result axon.O, err error) {
 
 // SendVoice sends a media group
 // see https://core.telegram.org/bots/api#sendmediagroup
-func (b *Bot) SendMediaGroup(request axon.O) (result axon.O, err error) {
+func (b *Bot) SendMediaGroup(ctx context.Context, request axon.O) (result axon.O, err error) {
 	var response interface{}
-	if response, err = b.doPostMultipart("sendMediaGroup", request); err == nil {
+	if response, err = b.doPostMultipart(ctx, "sendMediaGroup", request); err == nil {
 		result = response.(map[string]interface{})
 	}
 	return
@@ -159,39 +173,102 @@ func (b *Bot) SendMediaGroup(request axon.O) (result axon.O, err error) {
 
 // SendLocation sends a location
 // see https://core.telegram.org/bots/api#sendlocation
-func (b *Bot) SendLocation(request axon.O) (result axon.O, err error) {
+func (b *Bot) SendLocation(ctx context.Context, request axon.O) (result axon.O, err error) {
 	var response interface{}
-	if response, err = b.doPost("sendLocation", request); err == nil {
+	if response, err = b.doPost(ctx, "sendLocation", request); err == nil {
 		result = response.(map[string]interface{})
 	}
 	return
 }
 
-// EditMessageLiveLocation sends a location
+// EditMessageLiveLocation edits a live location message
 // see https://core.telegram.org/bots/api#editmessagelivelocation
-func (b *Bot) EditMessageLiveLocation(request axon.O) (result axon.O, err error) {
+func (b *Bot) EditMessageLiveLocation(ctx context.Context, request axon.O) (result MessageResult, err error) {
 	var response interface{}
-	if response, err = b.doPost("editMessageLiveLocation", request); err == nil {
-		result = response.(map[string]interface{})
+	if response, err = b.doPost(ctx, "editMessageLiveLocation", request); err == nil {
+		result, err = toMessageResult(response)
 	}
 	return
 }
 
-// StopMessageLiveLocation sends a location
+// StopMessageLiveLocation stops updating a live location message
 // see https://core.telegram.org/bots/api#stopmessagelivelocation
-func (b *Bot) StopMessageLiveLocation(request axon.O) (result axon.O, err error) {
+func (b *Bot) StopMessageLiveLocation(ctx context.Context, request axon.O) (result MessageResult, err error) {
 	var response interface{}
-	if response, err = b.doPost("stopMessageLiveLocation", request); err == nil {
-		result = response.(map[string]interface{})
+	if response, err = b.doPost(ctx, "stopMessageLiveLocation", request); err == nil {
+		result, err = toMessageResult(response)
+	}
+	return
+}
+
+// EditMessageText edits text and game messages
+// see https://core.telegram.org/bots/api#editmessagetext
+func (b *Bot) EditMessageText(ctx context.Context, request axon.O) (result MessageResult, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "editMessageText", request); err == nil {
+		result, err = toMessageResult(response)
+	}
+	return
+}
+
+// EditMessageCaption edits captions of messages
+// see https://core.telegram.org/bots/api#editmessagecaption
+func (b *Bot) EditMessageCaption(ctx context.Context, request axon.O) (result MessageResult, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "editMessageCaption", request); err == nil {
+		result, err = toMessageResult(response)
+	}
+	return
+}
+
+// EditMessageMedia edits animation, audio, document, photo, or video messages.
+// New files can be uploaded as UploadFile and referenced with attach://<field name>.
+// see https://core.telegram.org/bots/api#editmessagemedia
+func (b *Bot) EditMessageMedia(ctx context.Context, request axon.O) (result MessageResult, err error) {
+	var response interface{}
+	if response, err = b.doPostMultipart(ctx, "editMessageMedia", request); err == nil {
+		result, err = toMessageResult(response)
+	}
+	return
+}
+
+// EditMessageReplyMarkup edits only the reply markup of messages
+// see https://core.telegram.org/bots/api#editmessagereplymarkup
+func (b *Bot) EditMessageReplyMarkup(ctx context.Context, request axon.O) (result MessageResult, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "editMessageReplyMarkup", request); err == nil {
+		result, err = toMessageResult(response)
+	}
+	return
+}
+
+// DeleteMessage deletes a message, including service messages
+// see https://core.telegram.org/bots/api#deletemessage
+func (b *Bot) DeleteMessage(ctx context.Context, request axon.O) (result bool, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "deleteMessage", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsBool()
+	}
+	return
+}
+
+// DeleteMessages deletes multiple messages simultaneously
+// see https://core.telegram.org/bots/api#deletemessages
+func (b *Bot) DeleteMessages(ctx context.Context, request axon.O) (result bool, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "deleteMessages", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsBool()
 	}
 	return
 }
 
 // SendVenue sends a venue
 // see https://core.telegram.org/bots/api#sendvenue
-func (b *Bot) SendVenue(request axon.O) (result axon.O, err error) {
+func (b *Bot) SendVenue(ctx context.Context, request axon.O) (result axon.O, err error) {
 	var response interface{}
-	if response, err = b.doPost("sendVenue", request); err == nil {
+	if response, err = b.doPost(ctx, "sendVenue", request); err == nil {
 		result = response.(map[string]interface{})
 	}
 	return
@@ -199,9 +276,9 @@ func (b *Bot) SendVenue(request axon.O) (result axon.O, err error) {
 
 // SendContact sends a venue
 // see https://core.telegram.org/bots/api#sendcontact
-func (b *Bot) SendContact(request axon.O) (result axon.O, err error) {
+func (b *Bot) SendContact(ctx context.Context, request axon.O) (result axon.O, err error) {
 	var response interface{}
-	if response, err = b.doPost("sendContact", request); err == nil {
+	if response, err = b.doPost(ctx, "sendContact", request); err == nil {
 		result = response.(map[string]interface{})
 	}
 	return
@@ -209,9 +286,9 @@ func (b *Bot) SendContact(request axon.O) (result axon.O, err error) {
 
 // SendPoll sends a poll
 // see https://core.telegram.org/bots/api#sendpoll
-func (b *Bot) SendPoll(request axon.O) (result axon.O, err error) {
+func (b *Bot) SendPoll(ctx context.Context, request axon.O) (result axon.O, err error) {
 	var response interface{}
-	if response, err = b.doPost("sendPoll", request); err == nil {
+	if response, err = b.doPost(ctx, "sendPoll", request); err == nil {
 		result = response.(map[string]interface{})
 	}
 	return
@@ -219,19 +296,30 @@ func (b *Bot) SendPoll(request axon.O) (result axon.O, err error) {
 
 // SendDice sends a dice
 // see https://core.telegram.org/bots/api#senddice
-func (b *Bot) SendDice(request axon.O) (result axon.O, err error) {
+func (b *Bot) SendDice(ctx context.Context, request axon.O) (result axon.O, err error) {
 	var response interface{}
-	if response, err = b.doPost("sendDice", request); err == nil {
+	if response, err = b.doPost(ctx, "sendDice", request); err == nil {
 		result = response.(map[string]interface{})
 	}
 	return
 }
 
+// SetMessageReaction changes the chosen reactions on a message
+// see https://core.telegram.org/bots/api#setmessagereaction
+func (b *Bot) SetMessageReaction(ctx context.Context, request axon.O) (result bool, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "setMessageReaction", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsBool()
+	}
+	return
+}
+
 // SendChatAction sends a chat action
 // see https://core.telegram.org/bots/api#sendchataction
-func (b *Bot) SendChatAction(request axon.O) (result bool, err error) {
+func (b *Bot) SendChatAction(ctx context.Context, request axon.O) (result bool, err error) {
 	var response interface{}
-	if response, err = b.doPost("sendChatAction", request); err == nil {
+	if response, err = b.doPost(ctx, "sendChatAction", request); err == nil {
 		result = response.(bool)
 	}
 	return
@@ -239,9 +327,9 @@ func (b *Bot) SendChatAction(request axon.O) (result bool, err error) {
 
 // GetUserProfilePhotos gets user profile photos.
 // see https://core.telegram.org/bots/api#getuserprofilephotos
-func (b *Bot) GetUserProfilePhotos(request axon.O) (result axon.O, err error) {
+func (b *Bot) GetUserProfilePhotos(ctx context.Context, request axon.O) (result axon.O, err error) {
 	var response interface{}
-	if response, err = b.doPost("getUserProfilesPhotos", request); err == nil {
+	if response, err = b.doPost(ctx, "getUserProfilesPhotos", request); err == nil {
 		result = response.(map[string]interface{})
 	}
 	return
@@ -249,29 +337,37 @@ func (b *Bot) GetUserProfilePhotos(request axon.O) (result axon.O, err error) {
 
 // GetFile gets basic info about a file and prepare it for downloading.
 // see https://core.telegram.org/bots/api#getfile
-func (b *Bot) GetFile(fileId string) (result axon.O, err error) {
+func (b *Bot) GetFile(ctx context.Context, fileId string) (result axon.O, err error) {
 	var response interface{}
-	if response, err = b.doGet("getFile?file_id=" + fileId); err == nil {
+	if response, err = b.doGet(ctx, "getFile?file_id="+fileId); err == nil {
 		result = response.(map[string]interface{})
 	}
 	return
 }
 
-// KickChatMember kicks a user from a group, a supergroup or a channel.
-// see https://core.telegram.org/bots/api#kickchatmember
-func (b *Bot) KickChatMember(request axon.O) (result bool, err error) {
+// BanChatMember bans a user in a group, a supergroup or a channel.
+// see https://core.telegram.org/bots/api#banchatmember
+func (b *Bot) BanChatMember(ctx context.Context, request axon.O) (result bool, err error) {
 	var response interface{}
-	if response, err = b.doPost("kickChatMember", request); err == nil {
-		result = response.(bool)
+	if response, err = b.doPost(ctx, "banChatMember", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsBool()
 	}
 	return
 }
 
+// KickChatMember kicks a user from a group, a supergroup or a channel.
+//
+// Deprecated: kickChatMember has been renamed, use BanChatMember.
+func (b *Bot) KickChatMember(ctx context.Context, request axon.O) (result bool, err error) {
+	return b.BanChatMember(ctx, request)
+}
+
 // UnbanChatMember unban a previously kicked user in a supergroup or channel.
 // see https://core.telegram.org/bots/api#unbanchatmember
-func (b *Bot) UnbanChatMember(request axon.O) (result bool, err error) {
+func (b *Bot) UnbanChatMember(ctx context.Context, request axon.O) (result bool, err error) {
 	var response interface{}
-	if response, err = b.doPost("unbanChatMember", request); err == nil {
+	if response, err = b.doPost(ctx, "unbanChatMember", request); err == nil {
 		result = response.(bool)
 	}
 	return
@@ -279,9 +375,9 @@ func (b *Bot) UnbanChatMember(request axon.O) (result bool, err error) {
 
 // RestrictChatMember unban a previously kicked user in a supergroup or channel.
 // see https://core.telegram.org/bots/api#restrictchatmember
-func (b *Bot) RestrictChatMember(request axon.O) (result bool, err error) {
+func (b *Bot) RestrictChatMember(ctx context.Context, request axon.O) (result bool, err error) {
 	var response interface{}
-	if response, err = b.doPost("restrictChatMember", request); err == nil {
+	if response, err = b.doPost(ctx, "restrictChatMember", request); err == nil {
 		result = response.(bool)
 	}
 	return
@@ -289,29 +385,190 @@ func (b *Bot) RestrictChatMember(request axon.O) (result bool, err error) {
 
 // PromoteChatMember unban a previously kicked user in a supergroup or channel.
 // see https://core.telegram.org/bots/api#promotechatmember
-func (b *Bot) PromoteChatMember(request axon.O) (result bool, err error) {
+func (b *Bot) PromoteChatMember(ctx context.Context, request axon.O) (result bool, err error) {
 	var response interface{}
-	if response, err = b.doPost("promoteChatMember", request); err == nil {
+	if response, err = b.doPost(ctx, "promoteChatMember", request); err == nil {
 		result = response.(bool)
 	}
 	return
 }
 
+// SetChatAdministratorCustomTitle sets a custom title for an administrator in a supergroup promoted by the bot.
+// see https://core.telegram.org/bots/api#setchatadministratorcustomtitle
+func (b *Bot) SetChatAdministratorCustomTitle(ctx context.Context, request axon.O) (result bool, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "setChatAdministratorCustomTitle", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsBool()
+	}
+	return
+}
+
+// BanChatSenderChat bans a channel chat in a supergroup or a channel.
+// see https://core.telegram.org/bots/api#banchatsenderchat
+func (b *Bot) BanChatSenderChat(ctx context.Context, request axon.O) (result bool, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "banChatSenderChat", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsBool()
+	}
+	return
+}
+
+// UnbanChatSenderChat unbans a previously banned channel chat in a supergroup or channel.
+// see https://core.telegram.org/bots/api#unbanchatsenderchat
+func (b *Bot) UnbanChatSenderChat(ctx context.Context, request axon.O) (result bool, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "unbanChatSenderChat", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsBool()
+	}
+	return
+}
+
+// SetChatPermissions sets default chat permissions for all members.
+// see https://core.telegram.org/bots/api#setchatpermissions
+func (b *Bot) SetChatPermissions(ctx context.Context, request axon.O) (result bool, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "setChatPermissions", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsBool()
+	}
+	return
+}
+
+// SetChatPhoto sets a new profile photo for the chat, the photo has to be an UploadFile.
+// see https://core.telegram.org/bots/api#setchatphoto
+func (b *Bot) SetChatPhoto(ctx context.Context, request axon.O) (result bool, err error) {
+	var response interface{}
+	if response, err = b.doPostMultipart(ctx, "setChatPhoto", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsBool()
+	}
+	return
+}
+
+// DeleteChatPhoto deletes a chat photo.
+// see https://core.telegram.org/bots/api#deletechatphoto
+func (b *Bot) DeleteChatPhoto(ctx context.Context, request axon.O) (result bool, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "deleteChatPhoto", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsBool()
+	}
+	return
+}
+
+// SetChatTitle changes the title of a chat.
+// see https://core.telegram.org/bots/api#setchattitle
+func (b *Bot) SetChatTitle(ctx context.Context, request axon.O) (result bool, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "setChatTitle", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsBool()
+	}
+	return
+}
+
+// SetChatDescription changes the description of a group, a supergroup or a channel.
+// see https://core.telegram.org/bots/api#setchatdescription
+func (b *Bot) SetChatDescription(ctx context.Context, request axon.O) (result bool, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "setChatDescription", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsBool()
+	}
+	return
+}
+
+// ExportChatInviteLink generates a new primary invite link for a chat, revoking any previous one.
+// see https://core.telegram.org/bots/api#exportchatinvitelink
+func (b *Bot) ExportChatInviteLink(ctx context.Context, request axon.O) (result string, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "exportChatInviteLink", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsString()
+	}
+	return
+}
+
+// CreateChatInviteLink creates an additional invite link for a chat.
+// see https://core.telegram.org/bots/api#createchatinvitelink
+func (b *Bot) CreateChatInviteLink(ctx context.Context, request axon.O) (result axon.O, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "createChatInviteLink", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsObject()
+	}
+	return
+}
+
+// EditChatInviteLink edits a non-primary invite link created by the bot.
+// see https://core.telegram.org/bots/api#editchatinvitelink
+func (b *Bot) EditChatInviteLink(ctx context.Context, request axon.O) (result axon.O, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "editChatInviteLink", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsObject()
+	}
+	return
+}
+
+// RevokeChatInviteLink revokes an invite link created by the bot.
+// see https://core.telegram.org/bots/api#revokechatinvitelink
+func (b *Bot) RevokeChatInviteLink(ctx context.Context, request axon.O) (result axon.O, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "revokeChatInviteLink", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsObject()
+	}
+	return
+}
+
+// ApproveChatJoinRequest approves a chat join request.
+// see https://core.telegram.org/bots/api#approvechatjoinrequest
+func (b *Bot) ApproveChatJoinRequest(ctx context.Context, request axon.O) (result bool, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "approveChatJoinRequest", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsBool()
+	}
+	return
+}
+
+// DeclineChatJoinRequest declines a chat join request.
+// see https://core.telegram.org/bots/api#declinechatjoinrequest
+func (b *Bot) DeclineChatJoinRequest(ctx context.Context, request axon.O) (result bool, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "declineChatJoinRequest", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsBool()
+	}
+	return
+}
+
 // SetWebhook implements setWebhook from Telegram Bot API.
+// A self-signed certificate can be uploaded as an UploadFile in the certificate field.
 // see https://core.telegram.org/bots/api#setwebhook
-func (b *Bot) SetWebhook(request axon.O) (result bool, err error) {
+func (b *Bot) SetWebhook(ctx context.Context, request axon.O) (result bool, err error) {
 	var response interface{}
-	if response, err = b.doPost("setWebhook", request); err == nil {
-		result = response.(bool)
+	if _, upload := request["certificate"].(UploadFile); upload {
+		response, err = b.doPostMultipart(ctx, "setWebhook", request)
+	} else {
+		response, err = b.doPost(ctx, "setWebhook", request)
+	}
+	if err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsBool()
 	}
 	return
 }
 
 // DeleteWebhook implements deleteWebhook from Telegram Bot API
 // see https://core.telegram.org/bots/api#deletewebhook
-func (b *Bot) DeleteWebhook(request axon.O) (result bool, err error) {
+func (b *Bot) DeleteWebhook(ctx context.Context, request axon.O) (result bool, err error) {
 	var response interface{}
-	if response, err = b.doPost("deleteWebhook", request); err == nil {
+	if response, err = b.doPost(ctx, "deleteWebhook", request); err == nil {
 		result = response.(bool)
 	}
 	return
@@ -319,9 +576,9 @@ func (b *Bot) DeleteWebhook(request axon.O) (result bool, err error) {
 
 // GetWebhookInfo get current webhook status
 // https://core.telegram.org/bots/api#getwebhookinfo
-func (b *Bot) GetWebhookInfo() (result axon.O, err error) {
+func (b *Bot) GetWebhookInfo(ctx context.Context) (result axon.O, err error) {
 	var response interface{}
-	if response, err = b.doGet("getWebhookInfo"); err == nil {
+	if response, err = b.doGet(ctx, "getWebhookInfo"); err == nil {
 		result = response.(map[string]interface{})
 	}
 	return
@@ -329,9 +586,9 @@ func (b *Bot) GetWebhookInfo() (result axon.O, err error) {
 
 // PinChatMessage pins a message for the given chat
 // https://core.telegram.org/bots/api#pinchatmessage
-func (b *Bot) PinChatMessage(request axon.O) (result bool, err error) {
+func (b *Bot) PinChatMessage(ctx context.Context, request axon.O) (result bool, err error) {
 	var response interface{}
-	if response, err = b.doPost("pinChatMessage", request); err == nil {
+	if response, err = b.doPost(ctx, "pinChatMessage", request); err == nil {
 		result = response.(bool)
 	}
 	return
@@ -339,9 +596,9 @@ func (b *Bot) PinChatMessage(request axon.O) (result bool, err error) {
 
 // UnpinChatMessage removes a message from the list of pinned messages in a chat.
 // see https://core.telegram.org/bots/api#unpinchatmessage
-func (b *Bot) UnpinChatMessage(request axon.O) (result bool, err error) {
+func (b *Bot) UnpinChatMessage(ctx context.Context, request axon.O) (result bool, err error) {
 	var response interface{}
-	if response, err = b.doPost("unpinChatMessage", request); err == nil {
+	if response, err = b.doPost(ctx, "unpinChatMessage", request); err == nil {
 		result = response.(bool)
 	}
 	return
@@ -349,9 +606,9 @@ func (b *Bot) UnpinChatMessage(request axon.O) (result bool, err error) {
 
 // UnpinAllChatMessages clears the list of pinned messages in a chat.
 // see https://core.telegram.org/bots/api#unpinallchatmessages
-func (b *Bot) UnpinAllChatMessages(request axon.O) (result bool, err error) {
+func (b *Bot) UnpinAllChatMessages(ctx context.Context, request axon.O) (result bool, err error) {
 	var response interface{}
-	if response, err = b.doPost("unpinAllChatMessages", request); err == nil {
+	if response, err = b.doPost(ctx, "unpinAllChatMessages", request); err == nil {
 		result = response.(bool)
 	}
 	return
@@ -359,9 +616,9 @@ func (b *Bot) UnpinAllChatMessages(request axon.O) (result bool, err error) {
 
 // LeaveChat leave a group, supergroup or channel.
 // see https://core.telegram.org/bots/api#leavechat
-func (b *Bot) LeaveChat(request axon.O) (result bool, err error) {
+func (b *Bot) LeaveChat(ctx context.Context, request axon.O) (result bool, err error) {
 	var response interface{}
-	if response, err = b.doPost("leaveChat", request); err == nil {
+	if response, err = b.doPost(ctx, "leaveChat", request); err == nil {
 		result = response.(bool)
 	}
 	return
@@ -369,9 +626,9 @@ func (b *Bot) LeaveChat(request axon.O) (result bool, err error) {
 
 // GetChat get up to date information about the chat.
 // see https://core.telegram.org/bots/api#getchat
-func (b *Bot) GetChat(request axon.O) (result axon.O, err error) {
+func (b *Bot) GetChat(ctx context.Context, request axon.O) (result axon.O, err error) {
 	var response interface{}
-	if response, err = b.doPost("getChat", request); err == nil {
+	if response, err = b.doPost(ctx, "getChat", request); err == nil {
 		result = response.(map[string]interface{})
 	}
 	return
@@ -379,40 +636,69 @@ func (b *Bot) GetChat(request axon.O) (result axon.O, err error) {
 
 // GetChatAdministrators get the number of members in a chat.
 // see https://core.telegram.org/bots/api#getchatmemberscount
-func (b *Bot) GetChatAdministrators(request axon.O) (result axon.A, err error) {
+func (b *Bot) GetChatAdministrators(ctx context.Context, request axon.O) (result axon.A, err error) {
 	var response interface{}
-	if response, err = b.doPost("getChatAdministrators", request); err == nil {
+	if response, err = b.doPost(ctx, "getChatAdministrators", request); err == nil {
 		result = response.([]interface{})
 	}
 	return
 }
 
-// GetChatMembersCount get the number of members in a chat.
-// see https://core.telegram.org/bots/api#getchatmemberscount
-func (b *Bot) GetChatMembersCount(request axon.O) (result int64, err error) {
+// GetChatMemberCount get the number of members in a chat.
+// see https://core.telegram.org/bots/api#getchatmembercount
+func (b *Bot) GetChatMemberCount(ctx context.Context, request axon.O) (result int64, err error) {
 	var response interface{}
-	if response, err = b.doPost("getChatMembersCount", request); err == nil {
+	if response, err = b.doPost(ctx, "getChatMemberCount", request); err == nil {
 		v := axon.V{Value: response}
 		result, err = v.AsInteger()
 	}
 	return
 }
 
+// GetChatMembersCount get the number of members in a chat.
+//
+// Deprecated: getChatMembersCount has been renamed, use GetChatMemberCount.
+func (b *Bot) GetChatMembersCount(ctx context.Context, request axon.O) (result int64, err error) {
+	return b.GetChatMemberCount(ctx, request)
+}
+
 // GetChatMember gets information about a member of a chat.
 // see https://core.telegram.org/bots/api#getchatmember
-func (b *Bot) GetChatMember(request axon.O) (result axon.O, err error) {
+func (b *Bot) GetChatMember(ctx context.Context, request axon.O) (result axon.O, err error) {
 	var response interface{}
-	if response, err = b.doPost("getChatMember", request); err == nil {
+	if response, err = b.doPost(ctx, "getChatMember", request); err == nil {
 		result = response.(map[string]interface{})
 	}
 	return
 }
 
+// GetUserChatBoosts gets the list of boosts added to a chat by a user.
+// see https://core.telegram.org/bots/api#getuserchatboosts
+func (b *Bot) GetUserChatBoosts(ctx context.Context, request axon.O) (result axon.O, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "getUserChatBoosts", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsObject()
+	}
+	return
+}
+
+// GetBusinessConnection gets information about the connection of the bot with a business account.
+// see https://core.telegram.org/bots/api#getbusinessconnection
+func (b *Bot) GetBusinessConnection(ctx context.Context, request axon.O) (result axon.O, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "getBusinessConnection", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsObject()
+	}
+	return
+}
+
 // SetChatStickerSet  set a new group sticker set for a supergroup.
 // see https://core.telegram.org/bots/api#setchatstickerset
-func (b *Bot) SetChatStickerSet(request axon.O) (result bool, err error) {
+func (b *Bot) SetChatStickerSet(ctx context.Context, request axon.O) (result bool, err error) {
 	var response interface{}
-	if response, err = b.doPost("setChatStickerSet", request); err == nil {
+	if response, err = b.doPost(ctx, "setChatStickerSet", request); err == nil {
 		result = response.(bool)
 	}
 	return
@@ -420,9 +706,329 @@ func (b *Bot) SetChatStickerSet(request axon.O) (result bool, err error) {
 
 // DeleteChatStickerSet  set a new group sticker set for a supergroup.
 // see https://core.telegram.org/bots/api#deletechatstickerset
-func (b *Bot) DeleteChatStickerSet(request axon.O) (result bool, err error) {
+func (b *Bot) DeleteChatStickerSet(ctx context.Context, request axon.O) (result bool, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "deleteChatStickerSet", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsBool()
+	}
+	return
+}
+
+// SendSticker sends a static, animated or video sticker
+// see https://core.telegram.org/bots/api#sendsticker
+func (b *Bot) SendSticker(ctx context.Context, request axon.O) (result axon.O, err error) {
+	var response interface{}
+	if response, err = b.doPostMultipart(ctx, "sendSticker", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsObject()
+	}
+	return
+}
+
+// GetStickerSet gets a sticker set
+// see https://core.telegram.org/bots/api#getstickerset
+func (b *Bot) GetStickerSet(ctx context.Context, request axon.O) (result axon.O, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "getStickerSet", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsObject()
+	}
+	return
+}
+
+// GetCustomEmojiStickers gets information about custom emoji stickers by their identifiers
+// see https://core.telegram.org/bots/api#getcustomemojistickers
+func (b *Bot) GetCustomEmojiStickers(ctx context.Context, request axon.O) (result axon.A, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "getCustomEmojiStickers", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsArray()
+	}
+	return
+}
+
+// UploadStickerFile uploads a file with a sticker for later use in the sticker set methods
+// see https://core.telegram.org/bots/api#uploadstickerfile
+func (b *Bot) UploadStickerFile(ctx context.Context, request axon.O) (result axon.O, err error) {
+	var response interface{}
+	if response, err = b.doPostMultipart(ctx, "uploadStickerFile", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsObject()
+	}
+	return
+}
+
+// CreateNewStickerSet creates a new sticker set owned by a user.
+// Sticker files can be uploaded as UploadFile and referenced with attach://<field name>.
+// see https://core.telegram.org/bots/api#createnewstickerset
+func (b *Bot) CreateNewStickerSet(ctx context.Context, request axon.O) (result bool, err error) {
+	var response interface{}
+	if response, err = b.doPostMultipart(ctx, "createNewStickerSet", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsBool()
+	}
+	return
+}
+
+// AddStickerToSet adds a new sticker to a set created by the bot
+// see https://core.telegram.org/bots/api#addstickertoset
+func (b *Bot) AddStickerToSet(ctx context.Context, request axon.O) (result bool, err error) {
+	var response interface{}
+	if response, err = b.doPostMultipart(ctx, "addStickerToSet", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsBool()
+	}
+	return
+}
+
+// SetStickerPositionInSet moves a sticker in a set created by the bot to a specific position
+// see https://core.telegram.org/bots/api#setstickerpositioninset
+func (b *Bot) SetStickerPositionInSet(ctx context.Context, request axon.O) (result bool, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "setStickerPositionInSet", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsBool()
+	}
+	return
+}
+
+// DeleteStickerFromSet deletes a sticker from a set created by the bot
+// see https://core.telegram.org/bots/api#deletestickerfromset
+func (b *Bot) DeleteStickerFromSet(ctx context.Context, request axon.O) (result bool, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "deleteStickerFromSet", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsBool()
+	}
+	return
+}
+
+// ReplaceStickerInSet replaces an existing sticker in a sticker set with a new one
+// see https://core.telegram.org/bots/api#replacestickerinset
+func (b *Bot) ReplaceStickerInSet(ctx context.Context, request axon.O) (result bool, err error) {
+	var response interface{}
+	if response, err = b.doPostMultipart(ctx, "replaceStickerInSet", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsBool()
+	}
+	return
+}
+
+// SetStickerEmojiList changes the list of emoji assigned to a regular or custom emoji sticker
+// see https://core.telegram.org/bots/api#setstickeremojilist
+func (b *Bot) SetStickerEmojiList(ctx context.Context, request axon.O) (result bool, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "setStickerEmojiList", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsBool()
+	}
+	return
+}
+
+// SetStickerKeywords changes search keywords assigned to a regular or custom emoji sticker
+// see https://core.telegram.org/bots/api#setstickerkeywords
+func (b *Bot) SetStickerKeywords(ctx context.Context, request axon.O) (result bool, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "setStickerKeywords", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsBool()
+	}
+	return
+}
+
+// SetStickerMaskPosition changes the mask position of a mask sticker
+// see https://core.telegram.org/bots/api#setstickermaskposition
+func (b *Bot) SetStickerMaskPosition(ctx context.Context, request axon.O) (result bool, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "setStickerMaskPosition", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsBool()
+	}
+	return
+}
+
+// SetStickerSetTitle sets the title of a created sticker set
+// see https://core.telegram.org/bots/api#setstickersettitle
+func (b *Bot) SetStickerSetTitle(ctx context.Context, request axon.O) (result bool, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "setStickerSetTitle", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsBool()
+	}
+	return
+}
+
+// SetStickerSetThumbnail sets the thumbnail of a regular or mask sticker set
+// see https://core.telegram.org/bots/api#setstickersetthumbnail
+func (b *Bot) SetStickerSetThumbnail(ctx context.Context, request axon.O) (result bool, err error) {
+	var response interface{}
+	if response, err = b.doPostMultipart(ctx, "setStickerSetThumbnail", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsBool()
+	}
+	return
+}
+
+// SetCustomEmojiStickerSetThumbnail sets the thumbnail of a custom emoji sticker set
+// see https://core.telegram.org/bots/api#setcustomemojistickersetthumbnail
+func (b *Bot) SetCustomEmojiStickerSetThumbnail(ctx context.Context, request axon.O) (result bool, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "setCustomEmojiStickerSetThumbnail", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsBool()
+	}
+	return
+}
+
+// DeleteStickerSet deletes a sticker set that was created by the bot
+// see https://core.telegram.org/bots/api#deletestickerset
+func (b *Bot) DeleteStickerSet(ctx context.Context, request axon.O) (result bool, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "deleteStickerSet", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsBool()
+	}
+	return
+}
+
+// GetForumTopicIconStickers gets custom emoji stickers that can be used as a forum topic icon by any user.
+// see https://core.telegram.org/bots/api#getforumtopiciconstickers
+func (b *Bot) GetForumTopicIconStickers(ctx context.Context) (result axon.A, err error) {
+	var response interface{}
+	if response, err = b.doGet(ctx, "getForumTopicIconStickers"); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsArray()
+	}
+	return
+}
+
+// CreateForumTopic creates a topic in a forum supergroup chat.
+// see https://core.telegram.org/bots/api#createforumtopic
+func (b *Bot) CreateForumTopic(ctx context.Context, request axon.O) (result axon.O, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "createForumTopic", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsObject()
+	}
+	return
+}
+
+// EditForumTopic edits name and icon of a topic in a forum supergroup chat.
+// see https://core.telegram.org/bots/api#editforumtopic
+func (b *Bot) EditForumTopic(ctx context.Context, request axon.O) (result bool, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "editForumTopic", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsBool()
+	}
+	return
+}
+
+// CloseForumTopic closes an open topic in a forum supergroup chat.
+// see https://core.telegram.org/bots/api#closeforumtopic
+func (b *Bot) CloseForumTopic(ctx context.Context, request axon.O) (result bool, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "closeForumTopic", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsBool()
+	}
+	return
+}
+
+// ReopenForumTopic reopens a closed topic in a forum supergroup chat.
+// see https://core.telegram.org/bots/api#reopenforumtopic
+func (b *Bot) ReopenForumTopic(ctx context.Context, request axon.O) (result bool, err error) {
 	var response interface{}
-	if response, err = b.doPost("deleteChatStickerSet", request); err == nil {
+	if response, err = b.doPost(ctx, "reopenForumTopic", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsBool()
+	}
+	return
+}
+
+// DeleteForumTopic deletes a forum topic along with all its messages.
+// see https://core.telegram.org/bots/api#deleteforumtopic
+func (b *Bot) DeleteForumTopic(ctx context.Context, request axon.O) (result bool, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "deleteForumTopic", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsBool()
+	}
+	return
+}
+
+// UnpinAllForumTopicMessages clears the list of pinned messages in a forum topic.
+// see https://core.telegram.org/bots/api#unpinallforumtopicmessages
+func (b *Bot) UnpinAllForumTopicMessages(ctx context.Context, request axon.O) (result bool, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "unpinAllForumTopicMessages", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsBool()
+	}
+	return
+}
+
+// EditGeneralForumTopic edits the name of the 'General' topic in a forum supergroup chat.
+// see https://core.telegram.org/bots/api#editgeneralforumtopic
+func (b *Bot) EditGeneralForumTopic(ctx context.Context, request axon.O) (result bool, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "editGeneralForumTopic", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsBool()
+	}
+	return
+}
+
+// CloseGeneralForumTopic closes an open 'General' topic in a forum supergroup chat.
+// see https://core.telegram.org/bots/api#closegeneralforumtopic
+func (b *Bot) CloseGeneralForumTopic(ctx context.Context, request axon.O) (result bool, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "closeGeneralForumTopic", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsBool()
+	}
+	return
+}
+
+// ReopenGeneralForumTopic reopens a closed 'General' topic in a forum supergroup chat.
+// see https://core.telegram.org/bots/api#reopengeneralforumtopic
+func (b *Bot) ReopenGeneralForumTopic(ctx context.Context, request axon.O) (result bool, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "reopenGeneralForumTopic", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsBool()
+	}
+	return
+}
+
+// HideGeneralForumTopic hides the 'General' topic in a forum supergroup chat.
+// see https://core.telegram.org/bots/api#hidegeneralforumtopic
+func (b *Bot) HideGeneralForumTopic(ctx context.Context, request axon.O) (result bool, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "hideGeneralForumTopic", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsBool()
+	}
+	return
+}
+
+// UnhideGeneralForumTopic unhides the 'General' topic in a forum supergroup chat.
+// see https://core.telegram.org/bots/api#unhidegeneralforumtopic
+func (b *Bot) UnhideGeneralForumTopic(ctx context.Context, request axon.O) (result bool, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "unhideGeneralForumTopic", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsBool()
+	}
+	return
+}
+
+// UnpinAllGeneralForumTopicMessages clears the list of pinned messages in the 'General' forum topic.
+// see https://core.telegram.org/bots/api#unpinallgeneralforumtopicmessages
+func (b *Bot) UnpinAllGeneralForumTopicMessages(ctx context.Context, request axon.O) (result bool, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "unpinAllGeneralForumTopicMessages", request); err == nil {
 		v := axon.V{Value: response}
 		result, err = v.AsBool()
 	}
@@ -431,32 +1037,256 @@ func (b *Bot) DeleteChatStickerSet(request axon.O) (result bool, err error) {
 
 // AnswerCallbackQuery send an answer to the given callback query
 // https://core.telegram.org/bots/api#answercallbackquery
-func (b *Bot) AnswerCallbackQuery(request axon.O) (result bool, err error) {
+func (b *Bot) AnswerCallbackQuery(ctx context.Context, request axon.O) (result bool, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "answerCallbackQuery", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsBool()
+	}
+	return
+}
+
+// AnswerInlineQuery sends answers to an inline query
+// https://core.telegram.org/bots/api#answerinlinequery
+func (b *Bot) AnswerInlineQuery(ctx context.Context, request axon.O) (result bool, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "answerInlineQuery", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsBool()
+	}
+	return
+}
+
+// AnswerWebAppQuery sets the result of an interaction with a Web App and sends a message on behalf of the user,
+// the result is a SentWebAppMessage.
+// see https://core.telegram.org/bots/api#answerwebappquery
+func (b *Bot) AnswerWebAppQuery(ctx context.Context, request axon.O) (result axon.O, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "answerWebAppQuery", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsObject()
+	}
+	return
+}
+
+// SendInvoice sends an invoice
+// see https://core.telegram.org/bots/api#sendinvoice
+func (b *Bot) SendInvoice(ctx context.Context, request axon.O) (result axon.O, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "sendInvoice", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsObject()
+	}
+	return
+}
+
+// CreateInvoiceLink creates a link for an invoice
+// see https://core.telegram.org/bots/api#createinvoicelink
+func (b *Bot) CreateInvoiceLink(ctx context.Context, request axon.O) (result string, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "createInvoiceLink", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsString()
+	}
+	return
+}
+
+// AnswerShippingQuery replies to a shipping query
+// see https://core.telegram.org/bots/api#answershippingquery
+func (b *Bot) AnswerShippingQuery(ctx context.Context, request axon.O) (result bool, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "answerShippingQuery", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsBool()
+	}
+	return
+}
+
+// AnswerPreCheckoutQuery replies to a pre-checkout query.
+// See CheckoutHandler for answering within the API deadline.
+// see https://core.telegram.org/bots/api#answerprecheckoutquery
+func (b *Bot) AnswerPreCheckoutQuery(ctx context.Context, request axon.O) (result bool, err error) {
 	var response interface{}
-	if response, err = b.doPost("answerCallbackQuery", request); err == nil {
+	if response, err = b.doPost(ctx, "answerPreCheckoutQuery", request); err == nil {
 		v := axon.V{Value: response}
 		result, err = v.AsBool()
 	}
 	return
 }
 
-// SetMyCommands AnswerCallbackQuery send an answer to the given callback query
+// SendGame sends a game
+// see https://core.telegram.org/bots/api#sendgame
+func (b *Bot) SendGame(ctx context.Context, request axon.O) (result axon.O, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "sendGame", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsObject()
+	}
+	return
+}
+
+// SetGameScore sets the score of the specified user in a game message
+// see https://core.telegram.org/bots/api#setgamescore
+func (b *Bot) SetGameScore(ctx context.Context, request axon.O) (result MessageResult, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "setGameScore", request); err == nil {
+		result, err = toMessageResult(response)
+	}
+	return
+}
+
+// GetGameHighScores gets data for high score tables
+// see https://core.telegram.org/bots/api#getgamehighscores
+func (b *Bot) GetGameHighScores(ctx context.Context, request axon.O) (result axon.A, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "getGameHighScores", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsArray()
+	}
+	return
+}
+
+// SetMyCommands changes the list of the bot commands,
+// scope and language_code can be set in request.
 // https://core.telegram.org/bots/api#setmycommands
-func (b *Bot) SetMyCommands(request axon.O) (result bool, err error) {
+func (b *Bot) SetMyCommands(ctx context.Context, request axon.O) (result bool, err error) {
 	var response interface{}
-	if response, err = b.doPost("setMyCommands", request); err == nil {
+	if response, err = b.doPost(ctx, "setMyCommands", request); err == nil {
 		v := axon.V{Value: response}
 		result, err = v.AsBool()
 	}
 	return
 }
 
-// GetMyCommands send an answer to the given callback query
-// https://core.telegram.org/bots/api#getmycommands
-func (b *Bot) GetMyCommands() (result axon.A, err error) {
+// GetMyCommands gets the list of the bot commands for the given scope and user language,
+// scope and language_code can be set in request, a nil request gets the default commands.
+// see https://core.telegram.org/bots/api#getmycommands
+func (b *Bot) GetMyCommands(ctx context.Context, request axon.O) (result axon.A, err error) {
 	var response interface{}
-	if response, err = b.doGet("getMyCommands"); err == nil {
-		result = response.([]interface{})
+	if response, err = b.doPost(ctx, "getMyCommands", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsArray()
+	}
+	return
+}
+
+// DeleteMyCommands deletes the list of the bot commands for the given scope and user language.
+// see https://core.telegram.org/bots/api#deletemycommands
+func (b *Bot) DeleteMyCommands(ctx context.Context, request axon.O) (result bool, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "deleteMyCommands", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsBool()
+	}
+	return
+}
+
+// SetMyName changes the bot name.
+// see https://core.telegram.org/bots/api#setmyname
+func (b *Bot) SetMyName(ctx context.Context, request axon.O) (result bool, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "setMyName", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsBool()
+	}
+	return
+}
+
+// GetMyName gets the bot name for the given user language.
+// see https://core.telegram.org/bots/api#getmyname
+func (b *Bot) GetMyName(ctx context.Context, request axon.O) (result axon.O, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "getMyName", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsObject()
+	}
+	return
+}
+
+// SetMyDescription changes the bot description, shown in the chat with the bot if the chat is empty.
+// see https://core.telegram.org/bots/api#setmydescription
+func (b *Bot) SetMyDescription(ctx context.Context, request axon.O) (result bool, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "setMyDescription", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsBool()
+	}
+	return
+}
+
+// GetMyDescription gets the bot description for the given user language.
+// see https://core.telegram.org/bots/api#getmydescription
+func (b *Bot) GetMyDescription(ctx context.Context, request axon.O) (result axon.O, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "getMyDescription", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsObject()
+	}
+	return
+}
+
+// SetMyShortDescription changes the bot short description, shown on the bot profile page.
+// see https://core.telegram.org/bots/api#setmyshortdescription
+func (b *Bot) SetMyShortDescription(ctx context.Context, request axon.O) (result bool, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "setMyShortDescription", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsBool()
+	}
+	return
+}
+
+// GetMyShortDescription gets the bot short description for the given user language.
+// see https://core.telegram.org/bots/api#getmyshortdescription
+func (b *Bot) GetMyShortDescription(ctx context.Context, request axon.O) (result axon.O, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "getMyShortDescription", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsObject()
+	}
+	return
+}
+
+// SetChatMenuButton changes the bot menu button in a private chat, or the default menu button.
+// see https://core.telegram.org/bots/api#setchatmenubutton
+func (b *Bot) SetChatMenuButton(ctx context.Context, request axon.O) (result bool, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "setChatMenuButton", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsBool()
+	}
+	return
+}
+
+// GetChatMenuButton gets the current value of the bot menu button in a private chat, or the default menu button.
+// see https://core.telegram.org/bots/api#getchatmenubutton
+func (b *Bot) GetChatMenuButton(ctx context.Context, request axon.O) (result axon.O, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "getChatMenuButton", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsObject()
+	}
+	return
+}
+
+// SetMyDefaultAdministratorRights changes the default administrator rights requested by the bot when it's added as an administrator.
+// see https://core.telegram.org/bots/api#setmydefaultadministratorrights
+func (b *Bot) SetMyDefaultAdministratorRights(ctx context.Context, request axon.O) (result bool, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "setMyDefaultAdministratorRights", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsBool()
+	}
+	return
+}
+
+// GetMyDefaultAdministratorRights gets the current default administrator rights of the bot.
+// see https://core.telegram.org/bots/api#getmydefaultadministratorrights
+func (b *Bot) GetMyDefaultAdministratorRights(ctx context.Context, request axon.O) (result axon.O, err error) {
+	var response interface{}
+	if response, err = b.doPost(ctx, "getMyDefaultAdministratorRights", request); err == nil {
+		v := axon.V{Value: response}
+		result, err = v.AsObject()
 	}
 	return
 }
@@ -1,6 +1,7 @@
 package ubot
 
 import (
+	"context"
 	"errors"
 	"reflect"
 	"strings"
@@ -15,7 +16,7 @@ type mockAPIClient struct {
 	interfaceMethod func() interface{}
 }
 
-func (m *mockAPIClient) GetBytes(URL string) (result []byte, err error) {
+func (m *mockAPIClient) GetBytes(ctx context.Context, URL string) (result []byte, err error) {
 	if !strings.Contains(URL, m.method) {
 		err = errors.New("path don't match method")
 	} else {
@@ -24,7 +25,7 @@ func (m *mockAPIClient) GetBytes(URL string) (result []byte, err error) {
 	return
 }
 
-func (m *mockAPIClient) PostBytes(URL string, data interface{}) (result []byte, err error) {
+func (m *mockAPIClient) PostBytes(ctx context.Context, URL string, data interface{}) (result []byte, err error) {
 	if !strings.Contains(URL, m.method) {
 		err = errors.New("path don't match method")
 	} else {
@@ -33,7 +34,7 @@ func (m *mockAPIClient) PostBytes(URL string, data interface{}) (result []byte,
 	return
 }
 
-func (m *mockAPIClient) GetJson(URL string) (result interface{}, err error) {
+func (m *mockAPIClient) GetJson(ctx context.Context, URL string) (result interface{}, err error) {
 	if !strings.Contains(URL, m.method) {
 		err = errors.New("path don't match method")
 	} else {
@@ -41,7 +42,7 @@ func (m *mockAPIClient) GetJson(URL string) (result interface{}, err error) {
 	}
 	return
 }
-func (m *mockAPIClient) PostJson(URL string, request interface{}) (result interface{}, err error) {
+func (m *mockAPIClient) PostJson(ctx context.Context, URL string, request interface{}) (result interface{}, err error) {
 	if !strings.Contains(URL, m.method) {
 		err = errors.New("path don't match method")
 	} else {
@@ -49,7 +50,7 @@ func (m *mockAPIClient) PostJson(URL string, request interface{}) (result interf
 	}
 	return
 }
-func (m *mockAPIClient) PostMultipart(URL string, request axon.O) (result interface{}, err error) {
+func (m *mockAPIClient) PostMultipart(ctx context.Context, URL string, request axon.O) (result interface{}, err error) {
 	if !strings.Contains(URL, m.method) {
 		err = errors.New("path don't match method")
 	} else {
@@ -58,6 +59,49 @@ func (m *mockAPIClient) PostMultipart(URL string, request axon.O) (result interf
 	return
 }
 
+// recordingAPIClient records the last request posted
+type recordingAPIClient struct {
+	mockAPIClient
+	request   interface{}
+	multipart bool
+}
+
+func (r *recordingAPIClient) PostJson(ctx context.Context, URL string, request interface{}) (result interface{}, err error) {
+	r.request = request
+	return r.mockAPIClient.PostJson(ctx, URL, request)
+}
+
+func (r *recordingAPIClient) PostMultipart(ctx context.Context, URL string, request axon.O) (result interface{}, err error) {
+	r.request = request
+	r.multipart = true
+	return r.mockAPIClient.PostMultipart(ctx, URL, request)
+}
+
+// failingAPIClient is an apiClient whose requests always fail
+type failingAPIClient struct {
+	err error
+}
+
+func (f *failingAPIClient) GetBytes(ctx context.Context, URL string) (result []byte, err error) {
+	return nil, f.err
+}
+
+func (f *failingAPIClient) PostBytes(ctx context.Context, URL string, data interface{}) (result []byte, err error) {
+	return nil, f.err
+}
+
+func (f *failingAPIClient) GetJson(ctx context.Context, URL string) (result interface{}, err error) {
+	return nil, f.err
+}
+
+func (f *failingAPIClient) PostJson(ctx context.Context, URL string, request interface{}) (result interface{}, err error) {
+	return nil, f.err
+}
+
+func (f *failingAPIClient) PostMultipart(ctx context.Context, URL string, request axon.O) (result interface{}, err error) {
+	return nil, f.err
+}
+
 func TestBot_GetMe(t *testing.T) {
 	type fields struct {
 		Configuration Configuration
@@ -114,7 +158,7 @@ func TestBot_GetMe(t *testing.T) {
 				apiClient:     tt.fields.apiClient,
 				BotUser:       tt.fields.BotUser,
 			}
-			gotResult, err := b.GetMe()
+			gotResult, err := b.GetMe(context.Background())
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Bot.GetMe() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -178,7 +222,7 @@ func TestBot_LogOut(t *testing.T) {
 				myChatMemberMHs:       tt.fields.myChatMemberMHs,
 				chatMemberMHs:         tt.fields.chatMemberMHs,
 			}
-			if err := b.LogOut(); (err != nil) != tt.wantErr {
+			if err := b.LogOut(context.Background()); (err != nil) != tt.wantErr {
 				t.Errorf("Bot.LogOut() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
@@ -237,7 +281,7 @@ func TestBot_Close(t *testing.T) {
 				myChatMemberMHs:       tt.fields.myChatMemberMHs,
 				chatMemberMHs:         tt.fields.chatMemberMHs,
 			}
-			if err := b.Close(); (err != nil) != tt.wantErr {
+			if err := b.Close(context.Background()); (err != nil) != tt.wantErr {
 				t.Errorf("Bot.Close() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
@@ -304,7 +348,7 @@ func TestBot_SendMessage(t *testing.T) {
 				myChatMemberMHs:       tt.fields.myChatMemberMHs,
 				chatMemberMHs:         tt.fields.chatMemberMHs,
 			}
-			gotResult, err := b.SendMessage(tt.args.request)
+			gotResult, err := b.SendMessage(context.Background(), tt.args.request)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Bot.SendMessage() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -376,7 +420,7 @@ func TestBot_ForwardMessage(t *testing.T) {
 				myChatMemberMHs:       tt.fields.myChatMemberMHs,
 				chatMemberMHs:         tt.fields.chatMemberMHs,
 			}
-			gotResult, err := b.ForwardMessage(tt.args.request)
+			gotResult, err := b.ForwardMessage(context.Background(), tt.args.request)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Bot.SendMessage() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -428,7 +472,7 @@ func TestBot_CopyMessage(t *testing.T) {
 				Configuration: tt.fields.Configuration,
 				apiClient:     tt.fields.apiClient,
 			}
-			gotResult, err := b.CopyMessage(tt.args.request)
+			gotResult, err := b.CopyMessage(context.Background(), tt.args.request)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Bot.CopyMessage() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -480,7 +524,7 @@ func TestBot_GetMyCommands(t *testing.T) {
 				apiClient:     tt.fields.apiClient,
 				BotUser:       tt.fields.BotUser,
 			}
-			gotResult, err := b.GetMyCommands()
+			gotResult, err := b.GetMyCommands(context.Background(), axon.O{"language_code": "it"})
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Bot.GetMyCommands() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -492,6 +536,87 @@ func TestBot_GetMyCommands(t *testing.T) {
 	}
 }
 
+func TestBot_GetMyCommands_nilRequest(t *testing.T) {
+	client := &recordingAPIClient{
+		mockAPIClient: mockAPIClient{
+			method: "getMyCommands",
+			interfaceMethod: func() interface{} {
+				return []interface{}{}
+			},
+		},
+	}
+	b := &Bot{apiClient: client}
+	if _, err := b.GetMyCommands(context.Background(), nil); err != nil {
+		t.Errorf("Bot.GetMyCommands() error = %v", err)
+	}
+	if !reflect.DeepEqual(client.request, axon.O{}) {
+		t.Errorf("Bot.GetMyCommands() request = %#v, want an empty object", client.request)
+	}
+}
+
+func TestBot_getters_nilRequest(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		call   func(b *Bot) (axon.O, error)
+	}{
+		{
+			name:   "GetMyName",
+			method: "getMyName",
+			call: func(b *Bot) (axon.O, error) {
+				return b.GetMyName(context.Background(), nil)
+			},
+		},
+		{
+			name:   "GetMyDescription",
+			method: "getMyDescription",
+			call: func(b *Bot) (axon.O, error) {
+				return b.GetMyDescription(context.Background(), nil)
+			},
+		},
+		{
+			name:   "GetMyShortDescription",
+			method: "getMyShortDescription",
+			call: func(b *Bot) (axon.O, error) {
+				return b.GetMyShortDescription(context.Background(), nil)
+			},
+		},
+		{
+			name:   "GetChatMenuButton",
+			method: "getChatMenuButton",
+			call: func(b *Bot) (axon.O, error) {
+				return b.GetChatMenuButton(context.Background(), nil)
+			},
+		},
+		{
+			name:   "GetMyDefaultAdministratorRights",
+			method: "getMyDefaultAdministratorRights",
+			call: func(b *Bot) (axon.O, error) {
+				return b.GetMyDefaultAdministratorRights(context.Background(), nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &recordingAPIClient{
+				mockAPIClient: mockAPIClient{
+					method: tt.method,
+					interfaceMethod: func() interface{} {
+						return map[string]interface{}{}
+					},
+				},
+			}
+			b := &Bot{apiClient: client}
+			if _, err := tt.call(b); err != nil {
+				t.Errorf("Bot.%s() error = %v", tt.name, err)
+			}
+			if !reflect.DeepEqual(client.request, axon.O{}) {
+				t.Errorf("Bot.%s() request = %#v, want an empty object", tt.name, client.request)
+			}
+		})
+	}
+}
+
 func TestBot_SetMyCommands(t *testing.T) {
 	type fields struct {
 		Configuration Configuration
@@ -530,7 +655,7 @@ func TestBot_SetMyCommands(t *testing.T) {
 				Configuration: tt.fields.Configuration,
 				apiClient:     tt.fields.apiClient,
 			}
-			gotResult, err := b.SetMyCommands(tt.args.request)
+			gotResult, err := b.SetMyCommands(context.Background(), tt.args.request)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Bot.SetMyCommands() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -574,7 +699,7 @@ func TestBot_AnswerCallbackQuery(t *testing.T) {
 			b := &Bot{
 				apiClient: tt.fields.apiClient,
 			}
-			gotResult, err := b.AnswerCallbackQuery(nil)
+			gotResult, err := b.AnswerCallbackQuery(context.Background(), nil)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Bot.AnswerCallbackQuery() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -618,7 +743,7 @@ func TestBot_DeleteChatStickerSet(t *testing.T) {
 			b := &Bot{
 				apiClient: tt.fields.apiClient,
 			}
-			gotResult, err := b.DeleteChatStickerSet(nil)
+			gotResult, err := b.DeleteChatStickerSet(context.Background(), nil)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Bot.DeleteChatStickerSet() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -662,7 +787,7 @@ func TestBot_SetChatStickerSet(t *testing.T) {
 			b := &Bot{
 				apiClient: tt.fields.apiClient,
 			}
-			gotResult, err := b.SetChatStickerSet(nil)
+			gotResult, err := b.SetChatStickerSet(context.Background(), nil)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Bot.SetChatStickerSet() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -710,7 +835,7 @@ func TestBot_GetChatMember(t *testing.T) {
 			b := &Bot{
 				apiClient: tt.fields.apiClient,
 			}
-			gotResult, err := b.GetChatMember(axon.O{})
+			gotResult, err := b.GetChatMember(context.Background(), axon.O{})
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Bot.GetChatMember() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -736,7 +861,7 @@ func TestBot_GetChatMembersCount(t *testing.T) {
 			name: "test1",
 			fields: fields{
 				apiClient: &mockAPIClient{
-					method: "getChatMembersCount",
+					method: "getChatMemberCount",
 					interfaceMethod: func() interface{} {
 						return 10.
 					},
@@ -754,7 +879,7 @@ func TestBot_GetChatMembersCount(t *testing.T) {
 			b := &Bot{
 				apiClient: tt.fields.apiClient,
 			}
-			gotResult, err := b.GetChatMembersCount(axon.O{"chat_id": 123456})
+			gotResult, err := b.GetChatMembersCount(context.Background(), axon.O{"chat_id": 123456})
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Bot.GetChatMembersCount() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -802,7 +927,7 @@ func TestBot_GetChatAdministrators(t *testing.T) {
 			b := &Bot{
 				apiClient: tt.fields.apiClient,
 			}
-			gotResult, err := b.GetChatAdministrators(axon.O{})
+			gotResult, err := b.GetChatAdministrators(context.Background(), axon.O{})
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Bot.GetChatAdministrators() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -846,7 +971,7 @@ func TestBot_GetChat(t *testing.T) {
 			b := &Bot{
 				apiClient: tt.fields.apiClient,
 			}
-			gotResult, err := b.GetChat(axon.O{})
+			gotResult, err := b.GetChat(context.Background(), axon.O{})
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Bot.GetChat() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -890,7 +1015,7 @@ func TestBot_LeaveChat(t *testing.T) {
 			b := &Bot{
 				apiClient: tt.fields.apiClient,
 			}
-			gotResult, err := b.LeaveChat(axon.O{})
+			gotResult, err := b.LeaveChat(context.Background(), axon.O{})
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Bot.LeaveChat() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -934,7 +1059,7 @@ func TestBot_UnpinAllChatMessages(t *testing.T) {
 			b := &Bot{
 				apiClient: tt.fields.apiClient,
 			}
-			gotResult, err := b.UnpinAllChatMessages(nil)
+			gotResult, err := b.UnpinAllChatMessages(context.Background(), nil)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Bot.UnpinAllChatMessages() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -978,7 +1103,7 @@ func TestBot_UnpinChatMessage(t *testing.T) {
 			b := &Bot{
 				apiClient: tt.fields.apiClient,
 			}
-			gotResult, err := b.UnpinChatMessage(nil)
+			gotResult, err := b.UnpinChatMessage(context.Background(), nil)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Bot.UnpinChatMessage() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -1022,7 +1147,7 @@ func TestBot_PinChatMessage(t *testing.T) {
 			b := &Bot{
 				apiClient: tt.fields.apiClient,
 			}
-			gotResult, err := b.PinChatMessage(nil)
+			gotResult, err := b.PinChatMessage(context.Background(), nil)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Bot.PinChatMessage() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -1069,7 +1194,7 @@ func TestBot_GetWebhookInfo(t *testing.T) {
 			b := &Bot{
 				apiClient: tt.fields.apiClient,
 			}
-			gotResult, err := b.GetWebhookInfo()
+			gotResult, err := b.GetWebhookInfo(context.Background())
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Bot.GetWebhookInfo() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -1113,7 +1238,7 @@ func TestBot_DeleteWebhook(t *testing.T) {
 			b := &Bot{
 				apiClient: tt.fields.apiClient,
 			}
-			gotResult, err := b.DeleteWebhook(nil)
+			gotResult, err := b.DeleteWebhook(context.Background(), nil)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Bot.DeleteWebhook() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -1157,7 +1282,7 @@ func TestBot_SetWebhook(t *testing.T) {
 			b := &Bot{
 				apiClient: tt.fields.apiClient,
 			}
-			gotResult, err := b.SetWebhook(nil)
+			gotResult, err := b.SetWebhook(context.Background(), nil)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Bot.SetWebhook() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -1169,6 +1294,50 @@ func TestBot_SetWebhook(t *testing.T) {
 	}
 }
 
+func TestBot_SetWebhook_certificate(t *testing.T) {
+	tests := []struct {
+		name          string
+		request       axon.O
+		wantMultipart bool
+	}{
+		{
+			name: "plain",
+			request: axon.O{
+				"url":             "https://example.com/bot",
+				"max_connections": int32(10),
+			},
+			wantMultipart: false,
+		},
+		{
+			name: "certificate upload",
+			request: axon.O{
+				"url":         "https://example.com/bot",
+				"certificate": UploadFile{FileName: "certificate.pem", Data: []byte("PEM")},
+			},
+			wantMultipart: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &recordingAPIClient{
+				mockAPIClient: mockAPIClient{
+					method: "setWebhook",
+					interfaceMethod: func() interface{} {
+						return true
+					},
+				},
+			}
+			b := &Bot{apiClient: client}
+			if _, err := b.SetWebhook(context.Background(), tt.request); err != nil {
+				t.Errorf("Bot.SetWebhook() error = %v", err)
+			}
+			if client.multipart != tt.wantMultipart {
+				t.Errorf("Bot.SetWebhook() multipart = %v, want %v", client.multipart, tt.wantMultipart)
+			}
+		})
+	}
+}
+
 func TestBot_PromoteChatMember(t *testing.T) {
 	type fields struct {
 		apiClient apiClient
@@ -1201,7 +1370,7 @@ func TestBot_PromoteChatMember(t *testing.T) {
 			b := &Bot{
 				apiClient: tt.fields.apiClient,
 			}
-			gotResult, err := b.PromoteChatMember(axon.O{})
+			gotResult, err := b.PromoteChatMember(context.Background(), axon.O{})
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Bot.PromoteChatMember() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -1245,7 +1414,7 @@ func TestBot_RestrictChatMember(t *testing.T) {
 			b := &Bot{
 				apiClient: tt.fields.apiClient,
 			}
-			gotResult, err := b.RestrictChatMember(axon.O{})
+			gotResult, err := b.RestrictChatMember(context.Background(), axon.O{})
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Bot.RestrictChatMember() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -1289,7 +1458,7 @@ func TestBot_UnbanChatMember(t *testing.T) {
 			b := &Bot{
 				apiClient: tt.fields.apiClient,
 			}
-			gotResult, err := b.UnbanChatMember(axon.O{})
+			gotResult, err := b.UnbanChatMember(context.Background(), axon.O{})
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Bot.UnbanChatMember() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -1315,7 +1484,7 @@ func TestBot_KickChatMember(t *testing.T) {
 			name: "test1",
 			fields: fields{
 				apiClient: &mockAPIClient{
-					method: "kickChatMember",
+					method: "banChatMember",
 					interfaceMethod: func() interface{} {
 						return true
 					},
@@ -1333,7 +1502,7 @@ func TestBot_KickChatMember(t *testing.T) {
 			b := &Bot{
 				apiClient: tt.fields.apiClient,
 			}
-			gotResult, err := b.KickChatMember(axon.O{})
+			gotResult, err := b.KickChatMember(context.Background(), axon.O{})
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Bot.KickChatMember() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -1344,3 +1513,3223 @@ func TestBot_KickChatMember(t *testing.T) {
 		})
 	}
 }
+
+func TestBot_EditMessageText(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult MessageResult
+		wantErr    bool
+	}{
+		{
+			name: "message",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "editMessageText",
+					interfaceMethod: func() interface{} {
+						return map[string]interface{}{
+							"message_id": 123.,
+						}
+					},
+					bytesMethod: func() []byte {
+						return []byte("{}")
+					},
+				},
+			},
+			wantResult: MessageResult{
+				Message: axon.O{
+					"message_id": 123.,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "inline message",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "editMessageText",
+					interfaceMethod: func() interface{} {
+						return true
+					},
+					bytesMethod: func() []byte {
+						return []byte("true")
+					},
+				},
+			},
+			wantResult: MessageResult{
+				Inline: true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "unexpected result",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "editMessageText",
+					interfaceMethod: func() interface{} {
+						return "unexpected"
+					},
+					bytesMethod: func() []byte {
+						return []byte("\"unexpected\"")
+					},
+				},
+			},
+			wantResult: MessageResult{},
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.EditMessageText(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.EditMessageText() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(gotResult, tt.wantResult) {
+				t.Errorf("Bot.EditMessageText() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_EditMessageCaption(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult MessageResult
+		wantErr    bool
+	}{
+		{
+			name: "message",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "editMessageCaption",
+					interfaceMethod: func() interface{} {
+						return map[string]interface{}{
+							"message_id": 123.,
+						}
+					},
+					bytesMethod: func() []byte {
+						return []byte("{}")
+					},
+				},
+			},
+			wantResult: MessageResult{
+				Message: axon.O{
+					"message_id": 123.,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "inline message",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "editMessageCaption",
+					interfaceMethod: func() interface{} {
+						return true
+					},
+					bytesMethod: func() []byte {
+						return []byte("true")
+					},
+				},
+			},
+			wantResult: MessageResult{
+				Inline: true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "unexpected result",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "editMessageCaption",
+					interfaceMethod: func() interface{} {
+						return "unexpected"
+					},
+					bytesMethod: func() []byte {
+						return []byte("\"unexpected\"")
+					},
+				},
+			},
+			wantResult: MessageResult{},
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.EditMessageCaption(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.EditMessageCaption() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(gotResult, tt.wantResult) {
+				t.Errorf("Bot.EditMessageCaption() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_EditMessageMedia(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult MessageResult
+		wantErr    bool
+	}{
+		{
+			name: "message",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "editMessageMedia",
+					interfaceMethod: func() interface{} {
+						return map[string]interface{}{
+							"message_id": 123.,
+						}
+					},
+					bytesMethod: func() []byte {
+						return []byte("{}")
+					},
+				},
+			},
+			wantResult: MessageResult{
+				Message: axon.O{
+					"message_id": 123.,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "inline message",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "editMessageMedia",
+					interfaceMethod: func() interface{} {
+						return true
+					},
+					bytesMethod: func() []byte {
+						return []byte("true")
+					},
+				},
+			},
+			wantResult: MessageResult{
+				Inline: true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "unexpected result",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "editMessageMedia",
+					interfaceMethod: func() interface{} {
+						return "unexpected"
+					},
+					bytesMethod: func() []byte {
+						return []byte("\"unexpected\"")
+					},
+				},
+			},
+			wantResult: MessageResult{},
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.EditMessageMedia(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.EditMessageMedia() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(gotResult, tt.wantResult) {
+				t.Errorf("Bot.EditMessageMedia() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_EditMessageReplyMarkup(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult MessageResult
+		wantErr    bool
+	}{
+		{
+			name: "message",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "editMessageReplyMarkup",
+					interfaceMethod: func() interface{} {
+						return map[string]interface{}{
+							"message_id": 123.,
+						}
+					},
+					bytesMethod: func() []byte {
+						return []byte("{}")
+					},
+				},
+			},
+			wantResult: MessageResult{
+				Message: axon.O{
+					"message_id": 123.,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "inline message",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "editMessageReplyMarkup",
+					interfaceMethod: func() interface{} {
+						return true
+					},
+					bytesMethod: func() []byte {
+						return []byte("true")
+					},
+				},
+			},
+			wantResult: MessageResult{
+				Inline: true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "unexpected result",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "editMessageReplyMarkup",
+					interfaceMethod: func() interface{} {
+						return "unexpected"
+					},
+					bytesMethod: func() []byte {
+						return []byte("\"unexpected\"")
+					},
+				},
+			},
+			wantResult: MessageResult{},
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.EditMessageReplyMarkup(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.EditMessageReplyMarkup() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(gotResult, tt.wantResult) {
+				t.Errorf("Bot.EditMessageReplyMarkup() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_EditMessageLiveLocation(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult MessageResult
+		wantErr    bool
+	}{
+		{
+			name: "message",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "editMessageLiveLocation",
+					interfaceMethod: func() interface{} {
+						return map[string]interface{}{
+							"message_id": 123.,
+						}
+					},
+					bytesMethod: func() []byte {
+						return []byte("{}")
+					},
+				},
+			},
+			wantResult: MessageResult{
+				Message: axon.O{
+					"message_id": 123.,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "inline message",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "editMessageLiveLocation",
+					interfaceMethod: func() interface{} {
+						return true
+					},
+					bytesMethod: func() []byte {
+						return []byte("true")
+					},
+				},
+			},
+			wantResult: MessageResult{
+				Inline: true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "unexpected result",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "editMessageLiveLocation",
+					interfaceMethod: func() interface{} {
+						return "unexpected"
+					},
+					bytesMethod: func() []byte {
+						return []byte("\"unexpected\"")
+					},
+				},
+			},
+			wantResult: MessageResult{},
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.EditMessageLiveLocation(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.EditMessageLiveLocation() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(gotResult, tt.wantResult) {
+				t.Errorf("Bot.EditMessageLiveLocation() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_StopMessageLiveLocation(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult MessageResult
+		wantErr    bool
+	}{
+		{
+			name: "message",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "stopMessageLiveLocation",
+					interfaceMethod: func() interface{} {
+						return map[string]interface{}{
+							"message_id": 123.,
+						}
+					},
+					bytesMethod: func() []byte {
+						return []byte("{}")
+					},
+				},
+			},
+			wantResult: MessageResult{
+				Message: axon.O{
+					"message_id": 123.,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "inline message",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "stopMessageLiveLocation",
+					interfaceMethod: func() interface{} {
+						return true
+					},
+					bytesMethod: func() []byte {
+						return []byte("true")
+					},
+				},
+			},
+			wantResult: MessageResult{
+				Inline: true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "unexpected result",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "stopMessageLiveLocation",
+					interfaceMethod: func() interface{} {
+						return "unexpected"
+					},
+					bytesMethod: func() []byte {
+						return []byte("\"unexpected\"")
+					},
+				},
+			},
+			wantResult: MessageResult{},
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.StopMessageLiveLocation(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.StopMessageLiveLocation() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(gotResult, tt.wantResult) {
+				t.Errorf("Bot.StopMessageLiveLocation() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_DeleteMessage(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult bool
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "deleteMessage",
+					interfaceMethod: func() interface{} {
+						return true
+					},
+					bytesMethod: func() []byte {
+						return []byte("true")
+					},
+				},
+			},
+			wantResult: true,
+			wantErr:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.DeleteMessage(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.DeleteMessage() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if gotResult != tt.wantResult {
+				t.Errorf("Bot.DeleteMessage() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_DeleteMessages(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult bool
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "deleteMessages",
+					interfaceMethod: func() interface{} {
+						return true
+					},
+					bytesMethod: func() []byte {
+						return []byte("true")
+					},
+				},
+			},
+			wantResult: true,
+			wantErr:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.DeleteMessages(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.DeleteMessages() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if gotResult != tt.wantResult {
+				t.Errorf("Bot.DeleteMessages() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_AnswerInlineQuery(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult bool
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "answerInlineQuery",
+					interfaceMethod: func() interface{} {
+						return true
+					},
+					bytesMethod: func() []byte {
+						return []byte("true")
+					},
+				},
+			},
+			wantResult: true,
+			wantErr:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.AnswerInlineQuery(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.AnswerInlineQuery() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if gotResult != tt.wantResult {
+				t.Errorf("Bot.AnswerInlineQuery() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_SendSticker(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult axon.O
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "sendSticker",
+					interfaceMethod: func() interface{} {
+						return map[string]interface{}{
+							"message_id": 123.,
+						}
+					},
+					bytesMethod: func() []byte {
+						return []byte("{}")
+					},
+				},
+			},
+			wantResult: axon.O{
+				"message_id": 123.,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.SendSticker(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.SendSticker() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(gotResult, tt.wantResult) {
+				t.Errorf("Bot.SendSticker() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_GetStickerSet(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult axon.O
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "getStickerSet",
+					interfaceMethod: func() interface{} {
+						return map[string]interface{}{
+							"message_id": 123.,
+						}
+					},
+					bytesMethod: func() []byte {
+						return []byte("{}")
+					},
+				},
+			},
+			wantResult: axon.O{
+				"message_id": 123.,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.GetStickerSet(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.GetStickerSet() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(gotResult, tt.wantResult) {
+				t.Errorf("Bot.GetStickerSet() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_GetCustomEmojiStickers(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult axon.A
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "getCustomEmojiStickers",
+					interfaceMethod: func() interface{} {
+						return []interface{}{
+							map[string]interface{}{},
+						}
+					},
+					bytesMethod: func() []byte {
+						return []byte("[{}]")
+					},
+				},
+			},
+			wantResult: axon.A{
+				map[string]interface{}{},
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.GetCustomEmojiStickers(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.GetCustomEmojiStickers() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(gotResult, tt.wantResult) {
+				t.Errorf("Bot.GetCustomEmojiStickers() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_UploadStickerFile(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult axon.O
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "uploadStickerFile",
+					interfaceMethod: func() interface{} {
+						return map[string]interface{}{
+							"message_id": 123.,
+						}
+					},
+					bytesMethod: func() []byte {
+						return []byte("{}")
+					},
+				},
+			},
+			wantResult: axon.O{
+				"message_id": 123.,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.UploadStickerFile(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.UploadStickerFile() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(gotResult, tt.wantResult) {
+				t.Errorf("Bot.UploadStickerFile() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_CreateNewStickerSet(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult bool
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "createNewStickerSet",
+					interfaceMethod: func() interface{} {
+						return true
+					},
+					bytesMethod: func() []byte {
+						return []byte("true")
+					},
+				},
+			},
+			wantResult: true,
+			wantErr:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.CreateNewStickerSet(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.CreateNewStickerSet() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if gotResult != tt.wantResult {
+				t.Errorf("Bot.CreateNewStickerSet() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_AddStickerToSet(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult bool
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "addStickerToSet",
+					interfaceMethod: func() interface{} {
+						return true
+					},
+					bytesMethod: func() []byte {
+						return []byte("true")
+					},
+				},
+			},
+			wantResult: true,
+			wantErr:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.AddStickerToSet(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.AddStickerToSet() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if gotResult != tt.wantResult {
+				t.Errorf("Bot.AddStickerToSet() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_SetStickerPositionInSet(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult bool
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "setStickerPositionInSet",
+					interfaceMethod: func() interface{} {
+						return true
+					},
+					bytesMethod: func() []byte {
+						return []byte("true")
+					},
+				},
+			},
+			wantResult: true,
+			wantErr:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.SetStickerPositionInSet(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.SetStickerPositionInSet() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if gotResult != tt.wantResult {
+				t.Errorf("Bot.SetStickerPositionInSet() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_DeleteStickerFromSet(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult bool
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "deleteStickerFromSet",
+					interfaceMethod: func() interface{} {
+						return true
+					},
+					bytesMethod: func() []byte {
+						return []byte("true")
+					},
+				},
+			},
+			wantResult: true,
+			wantErr:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.DeleteStickerFromSet(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.DeleteStickerFromSet() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if gotResult != tt.wantResult {
+				t.Errorf("Bot.DeleteStickerFromSet() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_SetStickerSetThumbnail(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult bool
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "setStickerSetThumbnail",
+					interfaceMethod: func() interface{} {
+						return true
+					},
+					bytesMethod: func() []byte {
+						return []byte("true")
+					},
+				},
+			},
+			wantResult: true,
+			wantErr:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.SetStickerSetThumbnail(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.SetStickerSetThumbnail() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if gotResult != tt.wantResult {
+				t.Errorf("Bot.SetStickerSetThumbnail() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_SendInvoice(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult axon.O
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "sendInvoice",
+					interfaceMethod: func() interface{} {
+						return map[string]interface{}{
+							"message_id": 123.,
+						}
+					},
+					bytesMethod: func() []byte {
+						return []byte("{}")
+					},
+				},
+			},
+			wantResult: axon.O{
+				"message_id": 123.,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.SendInvoice(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.SendInvoice() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(gotResult, tt.wantResult) {
+				t.Errorf("Bot.SendInvoice() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_CreateInvoiceLink(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult string
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "createInvoiceLink",
+					interfaceMethod: func() interface{} {
+						return "result"
+					},
+					bytesMethod: func() []byte {
+						return []byte("\"result\"")
+					},
+				},
+			},
+			wantResult: "result",
+			wantErr:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.CreateInvoiceLink(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.CreateInvoiceLink() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if gotResult != tt.wantResult {
+				t.Errorf("Bot.CreateInvoiceLink() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_AnswerShippingQuery(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult bool
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "answerShippingQuery",
+					interfaceMethod: func() interface{} {
+						return true
+					},
+					bytesMethod: func() []byte {
+						return []byte("true")
+					},
+				},
+			},
+			wantResult: true,
+			wantErr:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.AnswerShippingQuery(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.AnswerShippingQuery() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if gotResult != tt.wantResult {
+				t.Errorf("Bot.AnswerShippingQuery() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_AnswerPreCheckoutQuery(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult bool
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "answerPreCheckoutQuery",
+					interfaceMethod: func() interface{} {
+						return true
+					},
+					bytesMethod: func() []byte {
+						return []byte("true")
+					},
+				},
+			},
+			wantResult: true,
+			wantErr:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.AnswerPreCheckoutQuery(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.AnswerPreCheckoutQuery() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if gotResult != tt.wantResult {
+				t.Errorf("Bot.AnswerPreCheckoutQuery() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_BanChatMember(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult bool
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "banChatMember",
+					interfaceMethod: func() interface{} {
+						return true
+					},
+					bytesMethod: func() []byte {
+						return []byte("true")
+					},
+				},
+			},
+			wantResult: true,
+			wantErr:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.BanChatMember(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.BanChatMember() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if gotResult != tt.wantResult {
+				t.Errorf("Bot.BanChatMember() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_GetChatMemberCount(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult int64
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "getChatMemberCount",
+					interfaceMethod: func() interface{} {
+						return 10.
+					},
+					bytesMethod: func() []byte {
+						return []byte("10")
+					},
+				},
+			},
+			wantResult: 10,
+			wantErr:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.GetChatMemberCount(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.GetChatMemberCount() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if gotResult != tt.wantResult {
+				t.Errorf("Bot.GetChatMemberCount() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_SetChatPermissions(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult bool
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "setChatPermissions",
+					interfaceMethod: func() interface{} {
+						return true
+					},
+					bytesMethod: func() []byte {
+						return []byte("true")
+					},
+				},
+			},
+			wantResult: true,
+			wantErr:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.SetChatPermissions(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.SetChatPermissions() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if gotResult != tt.wantResult {
+				t.Errorf("Bot.SetChatPermissions() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_SetChatTitle(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult bool
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "setChatTitle",
+					interfaceMethod: func() interface{} {
+						return true
+					},
+					bytesMethod: func() []byte {
+						return []byte("true")
+					},
+				},
+			},
+			wantResult: true,
+			wantErr:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.SetChatTitle(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.SetChatTitle() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if gotResult != tt.wantResult {
+				t.Errorf("Bot.SetChatTitle() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_SetChatDescription(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult bool
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "setChatDescription",
+					interfaceMethod: func() interface{} {
+						return true
+					},
+					bytesMethod: func() []byte {
+						return []byte("true")
+					},
+				},
+			},
+			wantResult: true,
+			wantErr:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.SetChatDescription(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.SetChatDescription() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if gotResult != tt.wantResult {
+				t.Errorf("Bot.SetChatDescription() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_SetChatPhoto(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult bool
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "setChatPhoto",
+					interfaceMethod: func() interface{} {
+						return true
+					},
+					bytesMethod: func() []byte {
+						return []byte("true")
+					},
+				},
+			},
+			wantResult: true,
+			wantErr:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.SetChatPhoto(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.SetChatPhoto() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if gotResult != tt.wantResult {
+				t.Errorf("Bot.SetChatPhoto() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_DeleteChatPhoto(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult bool
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "deleteChatPhoto",
+					interfaceMethod: func() interface{} {
+						return true
+					},
+					bytesMethod: func() []byte {
+						return []byte("true")
+					},
+				},
+			},
+			wantResult: true,
+			wantErr:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.DeleteChatPhoto(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.DeleteChatPhoto() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if gotResult != tt.wantResult {
+				t.Errorf("Bot.DeleteChatPhoto() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_SetChatAdministratorCustomTitle(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult bool
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "setChatAdministratorCustomTitle",
+					interfaceMethod: func() interface{} {
+						return true
+					},
+					bytesMethod: func() []byte {
+						return []byte("true")
+					},
+				},
+			},
+			wantResult: true,
+			wantErr:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.SetChatAdministratorCustomTitle(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.SetChatAdministratorCustomTitle() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if gotResult != tt.wantResult {
+				t.Errorf("Bot.SetChatAdministratorCustomTitle() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_BanChatSenderChat(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult bool
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "banChatSenderChat",
+					interfaceMethod: func() interface{} {
+						return true
+					},
+					bytesMethod: func() []byte {
+						return []byte("true")
+					},
+				},
+			},
+			wantResult: true,
+			wantErr:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.BanChatSenderChat(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.BanChatSenderChat() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if gotResult != tt.wantResult {
+				t.Errorf("Bot.BanChatSenderChat() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_UnbanChatSenderChat(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult bool
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "unbanChatSenderChat",
+					interfaceMethod: func() interface{} {
+						return true
+					},
+					bytesMethod: func() []byte {
+						return []byte("true")
+					},
+				},
+			},
+			wantResult: true,
+			wantErr:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.UnbanChatSenderChat(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.UnbanChatSenderChat() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if gotResult != tt.wantResult {
+				t.Errorf("Bot.UnbanChatSenderChat() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_ExportChatInviteLink(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult string
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "exportChatInviteLink",
+					interfaceMethod: func() interface{} {
+						return "result"
+					},
+					bytesMethod: func() []byte {
+						return []byte("\"result\"")
+					},
+				},
+			},
+			wantResult: "result",
+			wantErr:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.ExportChatInviteLink(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.ExportChatInviteLink() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if gotResult != tt.wantResult {
+				t.Errorf("Bot.ExportChatInviteLink() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_CreateChatInviteLink(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult axon.O
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "createChatInviteLink",
+					interfaceMethod: func() interface{} {
+						return map[string]interface{}{
+							"message_id": 123.,
+						}
+					},
+					bytesMethod: func() []byte {
+						return []byte("{}")
+					},
+				},
+			},
+			wantResult: axon.O{
+				"message_id": 123.,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.CreateChatInviteLink(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.CreateChatInviteLink() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(gotResult, tt.wantResult) {
+				t.Errorf("Bot.CreateChatInviteLink() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_EditChatInviteLink(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult axon.O
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "editChatInviteLink",
+					interfaceMethod: func() interface{} {
+						return map[string]interface{}{
+							"message_id": 123.,
+						}
+					},
+					bytesMethod: func() []byte {
+						return []byte("{}")
+					},
+				},
+			},
+			wantResult: axon.O{
+				"message_id": 123.,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.EditChatInviteLink(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.EditChatInviteLink() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(gotResult, tt.wantResult) {
+				t.Errorf("Bot.EditChatInviteLink() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_RevokeChatInviteLink(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult axon.O
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "revokeChatInviteLink",
+					interfaceMethod: func() interface{} {
+						return map[string]interface{}{
+							"message_id": 123.,
+						}
+					},
+					bytesMethod: func() []byte {
+						return []byte("{}")
+					},
+				},
+			},
+			wantResult: axon.O{
+				"message_id": 123.,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.RevokeChatInviteLink(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.RevokeChatInviteLink() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(gotResult, tt.wantResult) {
+				t.Errorf("Bot.RevokeChatInviteLink() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_ApproveChatJoinRequest(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult bool
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "approveChatJoinRequest",
+					interfaceMethod: func() interface{} {
+						return true
+					},
+					bytesMethod: func() []byte {
+						return []byte("true")
+					},
+				},
+			},
+			wantResult: true,
+			wantErr:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.ApproveChatJoinRequest(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.ApproveChatJoinRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if gotResult != tt.wantResult {
+				t.Errorf("Bot.ApproveChatJoinRequest() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_DeclineChatJoinRequest(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult bool
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "declineChatJoinRequest",
+					interfaceMethod: func() interface{} {
+						return true
+					},
+					bytesMethod: func() []byte {
+						return []byte("true")
+					},
+				},
+			},
+			wantResult: true,
+			wantErr:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.DeclineChatJoinRequest(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.DeclineChatJoinRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if gotResult != tt.wantResult {
+				t.Errorf("Bot.DeclineChatJoinRequest() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_GetForumTopicIconStickers(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult axon.A
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "getForumTopicIconStickers",
+					interfaceMethod: func() interface{} {
+						return []interface{}{
+							map[string]interface{}{},
+						}
+					},
+					bytesMethod: func() []byte {
+						return []byte("[{}]")
+					},
+				},
+			},
+			wantResult: axon.A{
+				map[string]interface{}{},
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.GetForumTopicIconStickers(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.GetForumTopicIconStickers() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(gotResult, tt.wantResult) {
+				t.Errorf("Bot.GetForumTopicIconStickers() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_CreateForumTopic(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult axon.O
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "createForumTopic",
+					interfaceMethod: func() interface{} {
+						return map[string]interface{}{
+							"message_id": 123.,
+						}
+					},
+					bytesMethod: func() []byte {
+						return []byte("{}")
+					},
+				},
+			},
+			wantResult: axon.O{
+				"message_id": 123.,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.CreateForumTopic(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.CreateForumTopic() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(gotResult, tt.wantResult) {
+				t.Errorf("Bot.CreateForumTopic() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_EditForumTopic(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult bool
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "editForumTopic",
+					interfaceMethod: func() interface{} {
+						return true
+					},
+					bytesMethod: func() []byte {
+						return []byte("true")
+					},
+				},
+			},
+			wantResult: true,
+			wantErr:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.EditForumTopic(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.EditForumTopic() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if gotResult != tt.wantResult {
+				t.Errorf("Bot.EditForumTopic() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_CloseForumTopic(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult bool
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "closeForumTopic",
+					interfaceMethod: func() interface{} {
+						return true
+					},
+					bytesMethod: func() []byte {
+						return []byte("true")
+					},
+				},
+			},
+			wantResult: true,
+			wantErr:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.CloseForumTopic(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.CloseForumTopic() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if gotResult != tt.wantResult {
+				t.Errorf("Bot.CloseForumTopic() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_ReopenForumTopic(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult bool
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "reopenForumTopic",
+					interfaceMethod: func() interface{} {
+						return true
+					},
+					bytesMethod: func() []byte {
+						return []byte("true")
+					},
+				},
+			},
+			wantResult: true,
+			wantErr:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.ReopenForumTopic(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.ReopenForumTopic() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if gotResult != tt.wantResult {
+				t.Errorf("Bot.ReopenForumTopic() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_DeleteForumTopic(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult bool
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "deleteForumTopic",
+					interfaceMethod: func() interface{} {
+						return true
+					},
+					bytesMethod: func() []byte {
+						return []byte("true")
+					},
+				},
+			},
+			wantResult: true,
+			wantErr:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.DeleteForumTopic(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.DeleteForumTopic() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if gotResult != tt.wantResult {
+				t.Errorf("Bot.DeleteForumTopic() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_UnpinAllForumTopicMessages(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult bool
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "unpinAllForumTopicMessages",
+					interfaceMethod: func() interface{} {
+						return true
+					},
+					bytesMethod: func() []byte {
+						return []byte("true")
+					},
+				},
+			},
+			wantResult: true,
+			wantErr:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.UnpinAllForumTopicMessages(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.UnpinAllForumTopicMessages() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if gotResult != tt.wantResult {
+				t.Errorf("Bot.UnpinAllForumTopicMessages() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_HideGeneralForumTopic(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult bool
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "hideGeneralForumTopic",
+					interfaceMethod: func() interface{} {
+						return true
+					},
+					bytesMethod: func() []byte {
+						return []byte("true")
+					},
+				},
+			},
+			wantResult: true,
+			wantErr:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.HideGeneralForumTopic(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.HideGeneralForumTopic() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if gotResult != tt.wantResult {
+				t.Errorf("Bot.HideGeneralForumTopic() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_UnpinAllGeneralForumTopicMessages(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult bool
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "unpinAllGeneralForumTopicMessages",
+					interfaceMethod: func() interface{} {
+						return true
+					},
+					bytesMethod: func() []byte {
+						return []byte("true")
+					},
+				},
+			},
+			wantResult: true,
+			wantErr:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.UnpinAllGeneralForumTopicMessages(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.UnpinAllGeneralForumTopicMessages() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if gotResult != tt.wantResult {
+				t.Errorf("Bot.UnpinAllGeneralForumTopicMessages() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_SendGame(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult axon.O
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "sendGame",
+					interfaceMethod: func() interface{} {
+						return map[string]interface{}{
+							"message_id": 123.,
+						}
+					},
+					bytesMethod: func() []byte {
+						return []byte("{}")
+					},
+				},
+			},
+			wantResult: axon.O{
+				"message_id": 123.,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.SendGame(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.SendGame() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(gotResult, tt.wantResult) {
+				t.Errorf("Bot.SendGame() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_SetGameScore(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult MessageResult
+		wantErr    bool
+	}{
+		{
+			name: "message",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "setGameScore",
+					interfaceMethod: func() interface{} {
+						return map[string]interface{}{
+							"message_id": 123.,
+						}
+					},
+					bytesMethod: func() []byte {
+						return []byte("{}")
+					},
+				},
+			},
+			wantResult: MessageResult{
+				Message: axon.O{
+					"message_id": 123.,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "inline message",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "setGameScore",
+					interfaceMethod: func() interface{} {
+						return true
+					},
+					bytesMethod: func() []byte {
+						return []byte("true")
+					},
+				},
+			},
+			wantResult: MessageResult{
+				Inline: true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "unexpected result",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "setGameScore",
+					interfaceMethod: func() interface{} {
+						return "unexpected"
+					},
+					bytesMethod: func() []byte {
+						return []byte("\"unexpected\"")
+					},
+				},
+			},
+			wantResult: MessageResult{},
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.SetGameScore(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.SetGameScore() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(gotResult, tt.wantResult) {
+				t.Errorf("Bot.SetGameScore() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_GetGameHighScores(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult axon.A
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "getGameHighScores",
+					interfaceMethod: func() interface{} {
+						return []interface{}{
+							map[string]interface{}{},
+						}
+					},
+					bytesMethod: func() []byte {
+						return []byte("[{}]")
+					},
+				},
+			},
+			wantResult: axon.A{
+				map[string]interface{}{},
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.GetGameHighScores(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.GetGameHighScores() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(gotResult, tt.wantResult) {
+				t.Errorf("Bot.GetGameHighScores() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_DeleteMyCommands(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult bool
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "deleteMyCommands",
+					interfaceMethod: func() interface{} {
+						return true
+					},
+					bytesMethod: func() []byte {
+						return []byte("true")
+					},
+				},
+			},
+			wantResult: true,
+			wantErr:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.DeleteMyCommands(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.DeleteMyCommands() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if gotResult != tt.wantResult {
+				t.Errorf("Bot.DeleteMyCommands() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_SetMyName(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult bool
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "setMyName",
+					interfaceMethod: func() interface{} {
+						return true
+					},
+					bytesMethod: func() []byte {
+						return []byte("true")
+					},
+				},
+			},
+			wantResult: true,
+			wantErr:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.SetMyName(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.SetMyName() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if gotResult != tt.wantResult {
+				t.Errorf("Bot.SetMyName() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_GetMyName(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult axon.O
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "getMyName",
+					interfaceMethod: func() interface{} {
+						return map[string]interface{}{
+							"message_id": 123.,
+						}
+					},
+					bytesMethod: func() []byte {
+						return []byte("{}")
+					},
+				},
+			},
+			wantResult: axon.O{
+				"message_id": 123.,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.GetMyName(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.GetMyName() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(gotResult, tt.wantResult) {
+				t.Errorf("Bot.GetMyName() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_SetMyDescription(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult bool
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "setMyDescription",
+					interfaceMethod: func() interface{} {
+						return true
+					},
+					bytesMethod: func() []byte {
+						return []byte("true")
+					},
+				},
+			},
+			wantResult: true,
+			wantErr:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.SetMyDescription(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.SetMyDescription() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if gotResult != tt.wantResult {
+				t.Errorf("Bot.SetMyDescription() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_GetMyDescription(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult axon.O
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "getMyDescription",
+					interfaceMethod: func() interface{} {
+						return map[string]interface{}{
+							"message_id": 123.,
+						}
+					},
+					bytesMethod: func() []byte {
+						return []byte("{}")
+					},
+				},
+			},
+			wantResult: axon.O{
+				"message_id": 123.,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.GetMyDescription(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.GetMyDescription() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(gotResult, tt.wantResult) {
+				t.Errorf("Bot.GetMyDescription() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_SetMyShortDescription(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult bool
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "setMyShortDescription",
+					interfaceMethod: func() interface{} {
+						return true
+					},
+					bytesMethod: func() []byte {
+						return []byte("true")
+					},
+				},
+			},
+			wantResult: true,
+			wantErr:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.SetMyShortDescription(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.SetMyShortDescription() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if gotResult != tt.wantResult {
+				t.Errorf("Bot.SetMyShortDescription() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_GetMyShortDescription(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult axon.O
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "getMyShortDescription",
+					interfaceMethod: func() interface{} {
+						return map[string]interface{}{
+							"message_id": 123.,
+						}
+					},
+					bytesMethod: func() []byte {
+						return []byte("{}")
+					},
+				},
+			},
+			wantResult: axon.O{
+				"message_id": 123.,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.GetMyShortDescription(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.GetMyShortDescription() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(gotResult, tt.wantResult) {
+				t.Errorf("Bot.GetMyShortDescription() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_SetChatMenuButton(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult bool
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "setChatMenuButton",
+					interfaceMethod: func() interface{} {
+						return true
+					},
+					bytesMethod: func() []byte {
+						return []byte("true")
+					},
+				},
+			},
+			wantResult: true,
+			wantErr:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.SetChatMenuButton(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.SetChatMenuButton() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if gotResult != tt.wantResult {
+				t.Errorf("Bot.SetChatMenuButton() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_GetChatMenuButton(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult axon.O
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "getChatMenuButton",
+					interfaceMethod: func() interface{} {
+						return map[string]interface{}{
+							"message_id": 123.,
+						}
+					},
+					bytesMethod: func() []byte {
+						return []byte("{}")
+					},
+				},
+			},
+			wantResult: axon.O{
+				"message_id": 123.,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.GetChatMenuButton(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.GetChatMenuButton() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(gotResult, tt.wantResult) {
+				t.Errorf("Bot.GetChatMenuButton() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_SetMyDefaultAdministratorRights(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult bool
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "setMyDefaultAdministratorRights",
+					interfaceMethod: func() interface{} {
+						return true
+					},
+					bytesMethod: func() []byte {
+						return []byte("true")
+					},
+				},
+			},
+			wantResult: true,
+			wantErr:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.SetMyDefaultAdministratorRights(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.SetMyDefaultAdministratorRights() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if gotResult != tt.wantResult {
+				t.Errorf("Bot.SetMyDefaultAdministratorRights() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_GetMyDefaultAdministratorRights(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult axon.O
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "getMyDefaultAdministratorRights",
+					interfaceMethod: func() interface{} {
+						return map[string]interface{}{
+							"message_id": 123.,
+						}
+					},
+					bytesMethod: func() []byte {
+						return []byte("{}")
+					},
+				},
+			},
+			wantResult: axon.O{
+				"message_id": 123.,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.GetMyDefaultAdministratorRights(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.GetMyDefaultAdministratorRights() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(gotResult, tt.wantResult) {
+				t.Errorf("Bot.GetMyDefaultAdministratorRights() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_SetMessageReaction(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult bool
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "setMessageReaction",
+					interfaceMethod: func() interface{} {
+						return true
+					},
+					bytesMethod: func() []byte {
+						return []byte("true")
+					},
+				},
+			},
+			wantResult: true,
+			wantErr:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.SetMessageReaction(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.SetMessageReaction() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if gotResult != tt.wantResult {
+				t.Errorf("Bot.SetMessageReaction() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_GetUserChatBoosts(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult axon.O
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "getUserChatBoosts",
+					interfaceMethod: func() interface{} {
+						return map[string]interface{}{
+							"message_id": 123.,
+						}
+					},
+					bytesMethod: func() []byte {
+						return []byte("{}")
+					},
+				},
+			},
+			wantResult: axon.O{
+				"message_id": 123.,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.GetUserChatBoosts(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.GetUserChatBoosts() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(gotResult, tt.wantResult) {
+				t.Errorf("Bot.GetUserChatBoosts() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_GetBusinessConnection(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult axon.O
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "getBusinessConnection",
+					interfaceMethod: func() interface{} {
+						return map[string]interface{}{
+							"message_id": 123.,
+						}
+					},
+					bytesMethod: func() []byte {
+						return []byte("{}")
+					},
+				},
+			},
+			wantResult: axon.O{
+				"message_id": 123.,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.GetBusinessConnection(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.GetBusinessConnection() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(gotResult, tt.wantResult) {
+				t.Errorf("Bot.GetBusinessConnection() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestBot_AnswerWebAppQuery(t *testing.T) {
+	type fields struct {
+		apiClient apiClient
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantResult axon.O
+		wantErr    bool
+	}{
+		{
+			name: "test1",
+			fields: fields{
+				apiClient: &mockAPIClient{
+					method: "answerWebAppQuery",
+					interfaceMethod: func() interface{} {
+						return map[string]interface{}{
+							"message_id": 123.,
+						}
+					},
+					bytesMethod: func() []byte {
+						return []byte("{}")
+					},
+				},
+			},
+			wantResult: axon.O{
+				"message_id": 123.,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{
+				apiClient: tt.fields.apiClient,
+			}
+			gotResult, err := b.AnswerWebAppQuery(context.Background(), axon.O{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Bot.AnswerWebAppQuery() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(gotResult, tt.wantResult) {
+				t.Errorf("Bot.AnswerWebAppQuery() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package ubot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/sdurz/axon"
+)
+
+// checkoutValidationTimeout is how long a CheckoutValidator can run, leaving room
+// to answer within the 10 seconds the API waits for the answer to a pre-checkout query.
+var checkoutValidationTimeout = 8 * time.Second
+
+// checkoutAnswerTimeout bounds the answerPreCheckoutQuery request.
+var checkoutAnswerTimeout = 2 * time.Second
+
+// errCheckoutTimeout is the rejection reason for validations that didn't complete in time
+var errCheckoutTimeout = errors.New("the payment could not be confirmed in time, please try again")
+
+// errCheckoutFailed is the rejection reason for validations that panicked
+var errCheckoutFailed = errors.New("the payment could not be confirmed, please try again")
+
+// CheckoutValidator checks a pre_checkout_query before the payment is confirmed.
+// Returning an error rejects the payment, the error message is shown to the user.
+type CheckoutValidator func(ctx context.Context, bot *Bot, preCheckoutQuery axon.O) error
+
+// CheckoutHandler returns a Handler for pre_checkout_query updates that runs validate and
+// always answers the query within the API deadline: the payment is accepted if validate
+// returns nil, and rejected if it returns an error, panics or doesn't complete in time.
+// Panics are reported to the ErrorHandler, the user only gets a generic rejection reason.
+func CheckoutHandler(validate CheckoutValidator) Handler {
+	return func(ctx context.Context, bot *Bot, preCheckoutQuery axon.O) (result bool, err error) {
+		var queryID string
+		if queryID, err = preCheckoutQuery.GetString("id"); err != nil {
+			return
+		}
+
+		validationCtx, cancelValidation := context.WithTimeout(ctx, checkoutValidationTimeout)
+		defer cancelValidation()
+		validated := make(chan error, 1)
+		go func() {
+			defer func() {
+				if r := recover(); r != nil {
+					bot.reportError(ctx, fmt.Errorf("checkout validator panic: %v", r))
+					validated <- errCheckoutFailed
+				}
+			}()
+			validated <- validate(validationCtx, bot, preCheckoutQuery)
+		}()
+
+		var validationErr error
+		select {
+		case validationErr = <-validated:
+		case <-validationCtx.Done():
+			validationErr = errCheckoutTimeout
+		}
+
+		answer := axon.O{
+			"pre_checkout_query_id": queryID,
+			"ok":                    validationErr == nil,
+		}
+		if validationErr != nil {
+			answer["error_message"] = validationErr.Error()
+		}
+		// the answer is due even if the bot is shutting down
+		answerCtx, cancelAnswer := context.WithTimeout(context.Background(), checkoutAnswerTimeout)
+		defer cancelAnswer()
+		_, err = bot.AnswerPreCheckoutQuery(answerCtx, answer)
+		result = true
+		return
+	}
+}
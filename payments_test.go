@@ -0,0 +1,101 @@
+package ubot
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/sdurz/axon"
+)
+
+func TestCheckoutHandler(t *testing.T) {
+	defer func(timeout time.Duration) {
+		checkoutValidationTimeout = timeout
+	}(checkoutValidationTimeout)
+	checkoutValidationTimeout = 20 * time.Millisecond
+
+	tests := []struct {
+		name         string
+		validate     CheckoutValidator
+		wantRequest  axon.O
+		wantReported bool
+	}{
+		{
+			name: "accepted",
+			validate: func(context.Context, *Bot, axon.O) error {
+				return nil
+			},
+			wantRequest: axon.O{
+				"pre_checkout_query_id": "query",
+				"ok":                    true,
+			},
+		},
+		{
+			name: "rejected",
+			validate: func(context.Context, *Bot, axon.O) error {
+				return errors.New("out of stock")
+			},
+			wantRequest: axon.O{
+				"pre_checkout_query_id": "query",
+				"ok":                    false,
+				"error_message":         "out of stock",
+			},
+		},
+		{
+			name: "panic",
+			validate: func(context.Context, *Bot, axon.O) error {
+				panic("boom")
+			},
+			wantRequest: axon.O{
+				"pre_checkout_query_id": "query",
+				"ok":                    false,
+				"error_message":         errCheckoutFailed.Error(),
+			},
+			wantReported: true,
+		},
+		{
+			name: "timeout",
+			validate: func(ctx context.Context, bot *Bot, query axon.O) error {
+				time.Sleep(time.Second)
+				return nil
+			},
+			wantRequest: axon.O{
+				"pre_checkout_query_id": "query",
+				"ok":                    false,
+				"error_message":         errCheckoutTimeout.Error(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &recordingAPIClient{
+				mockAPIClient: mockAPIClient{
+					method: "answerPreCheckoutQuery",
+					interfaceMethod: func() interface{} {
+						return true
+					},
+				},
+			}
+			b := &Bot{apiClient: client}
+			var reported error
+			b.SetErrorHandler(func(ctx context.Context, bot *Bot, err error) {
+				reported = err
+			})
+			stop, err := CheckoutHandler(tt.validate)(context.Background(), b, axon.O{"id": "query"})
+			if err != nil {
+				t.Errorf("CheckoutHandler() error = %v", err)
+			}
+			if !stop {
+				t.Errorf("CheckoutHandler() = %v, want true", stop)
+			}
+			if !reflect.DeepEqual(client.request, tt.wantRequest) {
+				t.Errorf("CheckoutHandler() request = %v, want %v", client.request, tt.wantRequest)
+			}
+			if (reported != nil) != tt.wantReported {
+				t.Errorf("CheckoutHandler() reported = %v, wantReported %v", reported, tt.wantReported)
+			}
+		})
+	}
+}
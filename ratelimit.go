@@ -0,0 +1,210 @@
+package ubot
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/sdurz/axon"
+)
+
+// DefaultRateLimits are the sending limits documented by Telegram.
+// see https://core.telegram.org/bots/faq#my-bot-is-hitting-limits-how-do-i-avoid-this
+var DefaultRateLimits = RateLimits{
+	GlobalPerSecond:      30,
+	PrivateChatPerSecond: 1,
+	GroupChatPerMinute:   20,
+}
+
+// RateLimits holds the limits enforced on outgoing requests.
+// A zero value disables the corresponding limit.
+type RateLimits struct {
+	// GlobalPerSecond is the overall number of requests per second, retries included.
+	GlobalPerSecond int `json:"global_per_second"`
+	// PrivateChatPerSecond is the number of requests per second to a single private chat,
+	// that is, requests whose chat_id is a user id.
+	PrivateChatPerSecond int `json:"private_chat_per_second"`
+	// GroupChatPerMinute is the number of requests per minute to a single group, supergroup or channel.
+	GroupChatPerMinute int `json:"group_chat_per_minute"`
+}
+
+// idleBucketsSweep is how often buckets of chats that went quiet are dropped
+const idleBucketsSweep = time.Minute
+
+// tokenBucket is a token bucket that allows capacity requests at once
+// and refills a token every interval.
+type tokenBucket struct {
+	capacity float64
+	interval time.Duration
+	tokens   float64
+	last     time.Time
+}
+
+func newTokenBucket(capacity int, period time.Duration, now time.Time) *tokenBucket {
+	return &tokenBucket{
+		capacity: float64(capacity),
+		interval: period / time.Duration(capacity),
+		tokens:   float64(capacity),
+		last:     now,
+	}
+}
+
+// refill adds the tokens accrued since last refill
+func (t *tokenBucket) refill(now time.Time) {
+	t.tokens += float64(now.Sub(t.last)) / float64(t.interval)
+	if t.tokens > t.capacity {
+		t.tokens = t.capacity
+	}
+	t.last = now
+}
+
+// reserve takes a token, returning how long the caller has to wait before using it
+func (t *tokenBucket) reserve(now time.Time) (wait time.Duration) {
+	t.refill(now)
+	t.tokens--
+	if t.tokens < 0 {
+		wait = time.Duration(-t.tokens * float64(t.interval))
+	}
+	return
+}
+
+// release gives back a token that has been reserved but not used
+func (t *tokenBucket) release() {
+	t.tokens++
+}
+
+// idle tells whether the bucket is full, that is, it can be dropped
+func (t *tokenBucket) idle(now time.Time) bool {
+	t.refill(now)
+	return t.tokens >= t.capacity
+}
+
+// rateLimiter enforces RateLimits. A nil rateLimiter enforces no limits.
+type rateLimiter struct {
+	mutex     sync.Mutex
+	limits    RateLimits
+	global    *tokenBucket
+	chats     map[string]*tokenBucket
+	lastSweep time.Time
+}
+
+// newRateLimiter returns a rateLimiter for the given limits, or nil if limits are disabled
+func newRateLimiter(limits RateLimits) (result *rateLimiter) {
+	if limits == (RateLimits{}) {
+		return
+	}
+	now := time.Now()
+	result = &rateLimiter{
+		limits:    limits,
+		chats:     map[string]*tokenBucket{},
+		lastSweep: now,
+	}
+	if limits.GlobalPerSecond > 0 {
+		result.global = newTokenBucket(limits.GlobalPerSecond, time.Second, now)
+	}
+	return
+}
+
+// wait blocks until request can be sent without exceeding the limits or ctx is done.
+// Every request is subject to the global limit, those with a chat_id to the limit of that chat.
+func (r *rateLimiter) wait(ctx context.Context, request interface{}) (err error) {
+	if r == nil {
+		return
+	}
+	var chatID interface{}
+	switch fields := request.(type) {
+	case axon.O:
+		chatID = fields["chat_id"]
+	case map[string]interface{}:
+		chatID = fields["chat_id"]
+	}
+
+	var (
+		delay    time.Duration
+		reserved []*tokenBucket
+	)
+	r.mutex.Lock()
+	now := time.Now()
+	if r.global != nil {
+		reserved = append(reserved, r.global)
+	}
+	if bucket := r.chatBucket(chatID, now); bucket != nil {
+		reserved = append(reserved, bucket)
+	}
+	for _, bucket := range reserved {
+		if wait := bucket.reserve(now); wait > delay {
+			delay = wait
+		}
+	}
+	r.sweep(now)
+	r.mutex.Unlock()
+
+	if delay <= 0 {
+		return
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-ctx.Done():
+		r.mutex.Lock()
+		for _, bucket := range reserved {
+			bucket.release()
+		}
+		r.mutex.Unlock()
+		err = ctx.Err()
+	}
+	return
+}
+
+// chatBucket returns the bucket for the given chat, creating it as needed.
+// Positive ids are private chats, negative ids and @usernames are groups and channels.
+func (r *rateLimiter) chatBucket(chatID interface{}, now time.Time) (result *tokenBucket) {
+	var (
+		key     string
+		private bool
+	)
+	switch id := chatID.(type) {
+	case int:
+		key, private = strconv.Itoa(id), id > 0
+	case int64:
+		key, private = strconv.FormatInt(id, 10), id > 0
+	case float64:
+		key, private = strconv.FormatInt(int64(id), 10), id > 0
+	case string:
+		key = id
+		if iID, err := strconv.ParseInt(id, 10, 64); err == nil {
+			key, private = strconv.FormatInt(iID, 10), iID > 0
+		}
+	default:
+		return
+	}
+
+	var ok bool
+	if result, ok = r.chats[key]; ok {
+		return
+	}
+	if private && r.limits.PrivateChatPerSecond > 0 {
+		result = newTokenBucket(r.limits.PrivateChatPerSecond, time.Second, now)
+	} else if !private && r.limits.GroupChatPerMinute > 0 {
+		result = newTokenBucket(r.limits.GroupChatPerMinute, time.Minute, now)
+	} else {
+		return
+	}
+	r.chats[key] = result
+	return
+}
+
+// sweep drops the buckets of idle chats
+func (r *rateLimiter) sweep(now time.Time) {
+	if now.Sub(r.lastSweep) < idleBucketsSweep {
+		return
+	}
+	for key, bucket := range r.chats {
+		if bucket.idle(now) {
+			delete(r.chats, key)
+		}
+	}
+	r.lastSweep = now
+}
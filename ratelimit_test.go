@@ -0,0 +1,124 @@
+package ubot
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sdurz/axon"
+)
+
+func Test_tokenBucket_reserve(t *testing.T) {
+	now := time.Now()
+	bucket := newTokenBucket(2, time.Second, now)
+
+	if wait := bucket.reserve(now); wait != 0 {
+		t.Errorf("tokenBucket.reserve() = %v, want 0", wait)
+	}
+	if wait := bucket.reserve(now); wait != 0 {
+		t.Errorf("tokenBucket.reserve() = %v, want 0", wait)
+	}
+	if wait := bucket.reserve(now); wait != 500*time.Millisecond {
+		t.Errorf("tokenBucket.reserve() = %v, want 500ms", wait)
+	}
+	if wait := bucket.reserve(now.Add(500 * time.Millisecond)); wait != 500*time.Millisecond {
+		t.Errorf("tokenBucket.reserve() = %v, want 500ms", wait)
+	}
+	if bucket.idle(now.Add(time.Second)) {
+		t.Errorf("tokenBucket.idle() = true, want false")
+	}
+	if !bucket.idle(now.Add(2 * time.Second)) {
+		t.Errorf("tokenBucket.idle() = false, want true")
+	}
+}
+
+func Test_rateLimiter_chatBucket(t *testing.T) {
+	limiter := newRateLimiter(RateLimits{PrivateChatPerSecond: 1, GroupChatPerMinute: 20})
+	now := time.Now()
+	tests := []struct {
+		name         string
+		chatID       interface{}
+		wantInterval time.Duration
+	}{
+		{name: "private chat", chatID: int64(1234), wantInterval: time.Second},
+		{name: "decoded private chat", chatID: 1234., wantInterval: time.Second},
+		{name: "group chat", chatID: -1001234, wantInterval: 3 * time.Second},
+		{name: "string group chat", chatID: "-1001234", wantInterval: 3 * time.Second},
+		{name: "channel username", chatID: "@channel", wantInterval: 3 * time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bucket := limiter.chatBucket(tt.chatID, now)
+			if bucket == nil {
+				t.Fatalf("rateLimiter.chatBucket() = nil")
+			}
+			if bucket.interval != tt.wantInterval {
+				t.Errorf("rateLimiter.chatBucket() interval = %v, want %v", bucket.interval, tt.wantInterval)
+			}
+		})
+	}
+	if len(limiter.chats) != 3 {
+		t.Errorf("rateLimiter.chats = %v buckets, want 3", len(limiter.chats))
+	}
+}
+
+func Test_rateLimiter_wait(t *testing.T) {
+	if newRateLimiter(RateLimits{}) != nil {
+		t.Errorf("newRateLimiter() should be nil for zero limits")
+	}
+
+	limiter := newRateLimiter(RateLimits{GroupChatPerMinute: 1})
+	request := axon.O{"chat_id": -1234}
+	if err := limiter.wait(context.Background(), request); err != nil {
+		t.Errorf("rateLimiter.wait() error = %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := limiter.wait(ctx, request); err != context.DeadlineExceeded {
+		t.Errorf("rateLimiter.wait() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if err := limiter.wait(ctx, axon.O{"text": "no chat"}); err != nil {
+		t.Errorf("rateLimiter.wait() error = %v", err)
+	}
+}
+
+func Test_rateLimiter_waitGlobal(t *testing.T) {
+	limiter := newRateLimiter(RateLimits{GlobalPerSecond: 1})
+	if err := limiter.wait(context.Background(), nil); err != nil {
+		t.Errorf("rateLimiter.wait() error = %v", err)
+	}
+
+	// requests without chat_id are subject to the global limit too
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := limiter.wait(ctx, map[string]interface{}{"text": "no chat"}); err != context.DeadlineExceeded {
+		t.Errorf("rateLimiter.wait() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func Test_httpApiClient_limitsRetries(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	client := &httpApiClient{
+		retryPolicy: RetryPolicy{MaxRetries: 3, MinBackoff: time.Millisecond},
+		limiter:     newRateLimiter(RateLimits{GlobalPerSecond: 1}),
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if _, err := client.PostJson(ctx, server.URL+"/getMe", axon.O{}); err == nil {
+		t.Errorf("httpApiClient.PostJson() should fail")
+	}
+	// the retry waits for a global token that isn't available within the deadline
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Errorf("httpApiClient.PostJson() sent %v requests, want 1", got)
+	}
+}
@@ -0,0 +1,154 @@
+package ubot
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	defaultMinBackoff = time.Second
+	defaultMaxBackoff = 30 * time.Second
+)
+
+// RetryPolicy controls how failed API requests are replayed.
+// Requests are replayed when the API server asks to wait (HTTP 429 with retry_after),
+// on server errors (HTTP 5xx) and on network errors.
+// The zero value disables retries.
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of times a request is replayed.
+	MaxRetries int `json:"max_retries"`
+	// MinBackoff is the delay before the first replay of a failed request, defaults to one second.
+	// Delays double on each subsequent replay.
+	// In a JSON configuration it's a duration string, such as "1.5s", or a number of seconds.
+	MinBackoff time.Duration `json:"min_backoff"`
+	// MaxBackoff caps the exponential backoff delay, defaults to 30 seconds.
+	// It doesn't apply to retry_after delays requested by the API server.
+	// In a JSON configuration it's a duration string, such as "1m", or a number of seconds.
+	MaxBackoff time.Duration `json:"max_backoff"`
+}
+
+// retryPolicyJSON is the JSON form of RetryPolicy
+type retryPolicyJSON struct {
+	MaxRetries int          `json:"max_retries"`
+	MinBackoff jsonDuration `json:"min_backoff"`
+	MaxBackoff jsonDuration `json:"max_backoff"`
+}
+
+// MarshalJSON implements json.Marshaler, backoff delays are written as duration strings.
+func (p RetryPolicy) MarshalJSON() ([]byte, error) {
+	return json.Marshal(retryPolicyJSON{
+		MaxRetries: p.MaxRetries,
+		MinBackoff: jsonDuration(p.MinBackoff),
+		MaxBackoff: jsonDuration(p.MaxBackoff),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler, backoff delays are read from duration strings
+// or numbers of seconds.
+func (p *RetryPolicy) UnmarshalJSON(data []byte) (err error) {
+	var policy retryPolicyJSON
+	if err = json.Unmarshal(data, &policy); err != nil {
+		return
+	}
+	*p = RetryPolicy{
+		MaxRetries: policy.MaxRetries,
+		MinBackoff: time.Duration(policy.MinBackoff),
+		MaxBackoff: time.Duration(policy.MaxBackoff),
+	}
+	return
+}
+
+// jsonDuration is a time.Duration that is encoded in JSON as a duration string,
+// and decoded from a duration string or a number of seconds.
+type jsonDuration time.Duration
+
+func (d jsonDuration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+func (d *jsonDuration) UnmarshalJSON(data []byte) (err error) {
+	var value interface{}
+	if err = json.Unmarshal(data, &value); err != nil {
+		return
+	}
+	switch v := value.(type) {
+	case string:
+		var duration time.Duration
+		if duration, err = time.ParseDuration(v); err == nil {
+			*d = jsonDuration(duration)
+		}
+	case float64:
+		*d = jsonDuration(v * float64(time.Second))
+	case nil:
+		*d = 0
+	default:
+		err = fmt.Errorf("invalid duration %s", data)
+	}
+	return
+}
+
+// do invokes call, replaying it as long as it fails with a retryable error
+// and the policy allows. Waiting between attempts stops when ctx is done.
+func (p RetryPolicy) do(ctx context.Context, logger Logger, call func() error) (err error) {
+	for attempt := 0; ; attempt++ {
+		if err = call(); err == nil || attempt >= p.MaxRetries || ctx.Err() != nil {
+			return
+		}
+		delay, retry := p.delay(err, attempt)
+		if !retry {
+			return
+		}
+		logger.Log(LevelWarn, "request failed, retrying", "delay", delay, "error", err)
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		}
+	}
+}
+
+// delay tells whether err is worth a retry and how long to wait before it.
+func (p RetryPolicy) delay(err error, attempt int) (delay time.Duration, retry bool) {
+	var (
+		apiErr    *APIError
+		statusErr *statusError
+		urlErr    *url.Error
+	)
+	switch {
+	case errors.As(err, &apiErr):
+		if apiErr.Parameters.RetryAfter > 0 {
+			return time.Duration(apiErr.Parameters.RetryAfter) * time.Second, true
+		}
+		retry = apiErr.ErrorCode == http.StatusTooManyRequests || apiErr.ErrorCode >= 500
+	case errors.As(err, &statusErr):
+		retry = statusErr.StatusCode >= 500
+	case errors.As(err, &urlErr):
+		retry = true
+	}
+	if !retry {
+		return
+	}
+
+	minBackoff, maxBackoff := p.MinBackoff, p.MaxBackoff
+	if minBackoff <= 0 {
+		minBackoff = defaultMinBackoff
+	}
+	if maxBackoff <= 0 {
+		maxBackoff = defaultMaxBackoff
+	}
+	delay = minBackoff
+	for i := 0; i < attempt && delay < maxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > maxBackoff {
+		delay = maxBackoff
+	}
+	return
+}
@@ -0,0 +1,161 @@
+package ubot
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicy_delay(t *testing.T) {
+	policy := RetryPolicy{
+		MaxRetries: 5,
+		MinBackoff: 100 * time.Millisecond,
+		MaxBackoff: time.Second,
+	}
+	tests := []struct {
+		name      string
+		err       error
+		attempt   int
+		wantDelay time.Duration
+		wantRetry bool
+	}{
+		{
+			name:      "flood wait",
+			err:       &APIError{ErrorCode: 429, Parameters: ResponseParameters{RetryAfter: 3}},
+			wantDelay: 3 * time.Second,
+			wantRetry: true,
+		},
+		{
+			name:      "server error",
+			err:       &APIError{ErrorCode: 502},
+			attempt:   2,
+			wantDelay: 400 * time.Millisecond,
+			wantRetry: true,
+		},
+		{
+			name:      "capped backoff",
+			err:       &statusError{StatusCode: 503},
+			attempt:   10,
+			wantDelay: time.Second,
+			wantRetry: true,
+		},
+		{
+			name:      "bad request",
+			err:       &APIError{ErrorCode: 400},
+			wantRetry: false,
+		},
+		{
+			name:      "unrelated error",
+			err:       errors.New("boom"),
+			wantRetry: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotDelay, gotRetry := policy.delay(tt.err, tt.attempt)
+			if gotRetry != tt.wantRetry {
+				t.Errorf("RetryPolicy.delay() retry = %v, want %v", gotRetry, tt.wantRetry)
+			}
+			if gotRetry && gotDelay != tt.wantDelay {
+				t.Errorf("RetryPolicy.delay() delay = %v, want %v", gotDelay, tt.wantDelay)
+			}
+		})
+	}
+}
+
+func Test_httpApiClient_retry(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch atomic.AddInt32(&calls, 1) {
+		case 1:
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"ok":false,"error_code":429,"description":"Too Many Requests: retry later"}`))
+		case 2:
+			w.WriteHeader(http.StatusBadGateway)
+			w.Write([]byte(`<html>Bad Gateway</html>`))
+		default:
+			w.Write([]byte(`{"ok":true,"result":true}`))
+		}
+	}))
+	defer server.Close()
+
+	client := &httpApiClient{retryPolicy: RetryPolicy{MaxRetries: 3, MinBackoff: time.Millisecond}}
+	result, err := client.PostJson(context.Background(), server.URL, map[string]interface{}{})
+	if err != nil {
+		t.Fatalf("httpApiClient.PostJson() error = %v", err)
+	}
+	if result != true {
+		t.Errorf("httpApiClient.PostJson() = %v, want true", result)
+	}
+	if calls != 3 {
+		t.Errorf("httpApiClient.PostJson() calls = %v, want 3", calls)
+	}
+
+	atomic.StoreInt32(&calls, 1)
+	client.retryPolicy.MaxRetries = 0
+	if _, err = client.PostJson(context.Background(), server.URL, map[string]interface{}{}); err == nil {
+		t.Errorf("httpApiClient.PostJson() expected error without retries")
+	}
+}
+
+func TestRetryPolicy_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    RetryPolicy
+		wantErr bool
+	}{
+		{
+			name: "duration strings",
+			data: `{"max_retries": 3, "min_backoff": "500ms", "max_backoff": "1m"}`,
+			want: RetryPolicy{MaxRetries: 3, MinBackoff: 500 * time.Millisecond, MaxBackoff: time.Minute},
+		},
+		{
+			name: "seconds",
+			data: `{"max_retries": 3, "min_backoff": 1.5, "max_backoff": 30}`,
+			want: RetryPolicy{MaxRetries: 3, MinBackoff: 1500 * time.Millisecond, MaxBackoff: 30 * time.Second},
+		},
+		{
+			name: "defaults",
+			data: `{"max_retries": 3}`,
+			want: RetryPolicy{MaxRetries: 3},
+		},
+		{
+			name:    "invalid duration",
+			data:    `{"min_backoff": "soon"}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got RetryPolicy
+			err := json.Unmarshal([]byte(tt.data), &got)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RetryPolicy.UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("RetryPolicy.UnmarshalJSON() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryPolicy_MarshalJSON(t *testing.T) {
+	policy := RetryPolicy{MaxRetries: 3, MinBackoff: 500 * time.Millisecond, MaxBackoff: time.Minute}
+	data, err := json.Marshal(policy)
+	if err != nil {
+		t.Fatalf("RetryPolicy.MarshalJSON() error = %v", err)
+	}
+	if want := `{"max_retries":3,"min_backoff":"500ms","max_backoff":"1m0s"}`; string(data) != want {
+		t.Errorf("RetryPolicy.MarshalJSON() = %s, want %s", data, want)
+	}
+	var got RetryPolicy
+	if err = json.Unmarshal(data, &got); err != nil || got != policy {
+		t.Errorf("RetryPolicy round trip = %+v, %v, want %+v", got, err, policy)
+	}
+}
@@ -2,71 +2,96 @@ package ubot
 
 import (
 	"context"
-	"encoding/json"
-	"io/ioutil"
-	"log"
+	"errors"
+	"fmt"
 	"net/http"
 	"time"
 
 	"github.com/sdurz/axon"
 )
 
+// webhookRequest returns the setWebhook request of ServerSource,
+// certificate is uploaded when not nil.
+func webhookRequest(configuration Configuration, secretToken string, certificate []byte) axon.O {
+	request := axon.O{
+		"url":          configuration.WebhookUrl,
+		"secret_token": secretToken,
+	}
+	if certificate != nil {
+		request["certificate"] = UploadFile{FileName: "certificate.pem", Data: certificate}
+	}
+	if configuration.WebhookIPAddress != "" {
+		request["ip_address"] = configuration.WebhookIPAddress
+	}
+	if configuration.WebhookMaxConnections > 0 {
+		request["max_connections"] = int64(configuration.WebhookMaxConnections)
+	}
+	if len(configuration.AllowedUpdates) > 0 {
+		request["allowed_updates"] = configuration.AllowedUpdates
+	}
+	if configuration.WebhookDropPendingUpdates {
+		request["drop_pending_updates"] = true
+	}
+	return request
+}
+
 // ServerSource is ServerSource that receives updates by exposing an http endpoint.
-// The endpoint is exposed at http://hostname:<port>/bot<apiToken>.
+// The endpoint is exposed at http://hostname:<port>/bot<apiToken>, served over HTTPS
+// when a certificate is configured, see Configuration.WebhookCertFile and WebhookSelfSignedHost.
+// Requests must carry the webhook secret token, see Configuration.WebhookSecretToken,
+// and can be restricted to TelegramSubnets with Configuration.WebhookCheckIP.
+// Use NewWebhookHandler to serve the webhook from an existing http server.
 func ServerSource(bot *Bot, ctx context.Context, updatesChan chan axon.O) {
-	serverHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		var (
-			body      []byte
-			rawUpdate interface{}
-			update    axon.O
-			err       error
-			ok        bool
-		)
+	if bot.Configuration.WebhookUrl == "" {
+		bot.reportError(ctx, errors.New("empty webhook url"))
+		return
+	}
 
-		body, err = ioutil.ReadAll(r.Body)
-		if err != nil {
-			log.Printf("Error reading body: %v", err)
-			http.Error(w, "can't read body", http.StatusBadRequest)
-			return
-		}
-		log.Println(string(body))
-		if err = json.Unmarshal(body, &rawUpdate); err != nil {
-			log.Printf("Error decoding body: %v", err)
-			http.Error(w, "can't decode body", http.StatusBadRequest)
-			return
-		}
-		if update, ok = rawUpdate.(map[string]interface{}); !ok {
-			log.Printf("Error decoding body: %v", err)
-			return
-		}
-		if err = bot.process(ctx, update); err != nil {
-			log.Println("Update processing error: ", err)
-		}
-	})
+	handler, err := NewWebhookHandler(ctx, bot)
+	if err != nil {
+		bot.reportError(ctx, err)
+		return
+	}
 
-	if bot.Configuration.WebhookUrl == "" {
-		log.Fatal("empty webhook url")
+	tlsConfig, certificate, err := webhookTLS(bot.Configuration)
+	if err != nil {
+		bot.reportError(ctx, fmt.Errorf("can't set up TLS: %w", err))
+		return
 	}
 
 	mux := http.NewServeMux()
-	mux.Handle("/bot"+bot.Configuration.APIToken, serverHandler)
+	mux.Handle("/bot"+bot.Configuration.APIToken, handler)
 	srv := &http.Server{
-		Addr:    bot.Configuration.ServerPort,
-		Handler: mux,
+		Addr:      bot.Configuration.ServerPort,
+		Handler:   mux,
+		TLSConfig: tlsConfig,
 	}
-	if ok, err := bot.SetWebhook(axon.O{"url": bot.Configuration.WebhookUrl}); !ok || err != nil {
-		log.Fatal("can't set webhook")
+	if ok, err := bot.SetWebhook(ctx, webhookRequest(bot.Configuration, handler.SecretToken, certificate)); err != nil {
+		bot.reportError(ctx, fmt.Errorf("can't set webhook: %w", err))
+		return
+	} else if !ok {
+		bot.reportError(ctx, errors.New("can't set webhook: request not accepted"))
 		return
 	}
-	go http.ListenAndServe(bot.Configuration.ServerPort, mux)
+	go func() {
+		var err error
+		if tlsConfig != nil {
+			err = srv.ListenAndServeTLS("", "")
+		} else {
+			err = srv.ListenAndServe()
+		}
+		if err != nil && err != http.ErrServerClosed {
+			bot.reportError(ctx, fmt.Errorf("webhook server failed: %w", err))
+		}
+	}()
 	<-ctx.Done()
 
 	ctxShutDown, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
 	if err := srv.Shutdown(ctxShutDown); err != nil {
-		log.Fatalf("server shutdown cailed: %+s", err)
+		bot.reportError(ctx, fmt.Errorf("server shutdown failed: %w", err))
 	}
 
-	log.Println("Server stopped")
+	bot.log().Log(LevelInfo, "server stopped")
 }
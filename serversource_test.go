@@ -0,0 +1,164 @@
+package ubot
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/sdurz/axon"
+)
+
+func TestServerSource_errors(t *testing.T) {
+	tests := []struct {
+		name          string
+		configuration Configuration
+		apiClient     apiClient
+		wantErr       string
+	}{
+		{
+			name:          "empty webhook url",
+			configuration: Configuration{APIToken: "123:abc"},
+			apiClient:     &failingAPIClient{err: errors.New("unexpected call")},
+			wantErr:       "empty webhook url",
+		},
+		{
+			name: "set webhook failure",
+			configuration: Configuration{
+				APIToken:   "123:abc",
+				WebhookUrl: "https://example.com/bot123:abc",
+				ServerPort: "127.0.0.1:0",
+			},
+			apiClient: &failingAPIClient{err: errors.New("dns glitch")},
+			wantErr:   "can't set webhook: dns glitch",
+		},
+		{
+			name: "set webhook not accepted",
+			configuration: Configuration{
+				APIToken:   "123:abc",
+				WebhookUrl: "https://example.com/bot123:abc",
+				ServerPort: "127.0.0.1:0",
+			},
+			apiClient: &mockAPIClient{
+				method: "setWebhook",
+				interfaceMethod: func() interface{} {
+					return false
+				},
+			},
+			wantErr: "can't set webhook: request not accepted",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var reported error
+			b := &Bot{Configuration: tt.configuration, apiClient: tt.apiClient}
+			b.SetErrorHandler(func(ctx context.Context, bot *Bot, err error) {
+				reported = err
+			})
+
+			done := make(chan struct{})
+			go func() {
+				ServerSource(b, context.Background(), make(chan axon.O))
+				close(done)
+			}()
+			select {
+			case <-done:
+			case <-time.After(time.Second):
+				t.Fatalf("ServerSource() didn't return")
+			}
+			if reported == nil || reported.Error() != tt.wantErr {
+				t.Errorf("ServerSource() reported %v, want %q", reported, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestServerSource_secretToken(t *testing.T) {
+	tests := []struct {
+		name            string
+		configuredToken string
+	}{
+		{
+			name:            "configured",
+			configuredToken: "s3cr3t",
+		},
+		{
+			name: "generated",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &recordingAPIClient{
+				mockAPIClient: mockAPIClient{
+					method: "setWebhook",
+					interfaceMethod: func() interface{} {
+						// stop before serving
+						return false
+					},
+				},
+			}
+			b := &Bot{
+				Configuration: Configuration{
+					APIToken:           "123:abc",
+					WebhookUrl:         "https://example.com/bot123:abc",
+					ServerPort:         "127.0.0.1:0",
+					WebhookSecretToken: tt.configuredToken,
+				},
+				apiClient: client,
+			}
+			ServerSource(b, context.Background(), make(chan axon.O))
+
+			request, _ := client.request.(axon.O)
+			secretToken, _ := request.GetString("secret_token")
+			if secretToken == "" || (tt.configuredToken != "" && secretToken != tt.configuredToken) {
+				t.Errorf("ServerSource() secret_token = %q, configured %q", secretToken, tt.configuredToken)
+			}
+		})
+	}
+}
+
+func Test_webhookRequest(t *testing.T) {
+	tests := []struct {
+		name          string
+		configuration Configuration
+		certificate   []byte
+		want          axon.O
+	}{
+		{
+			name:          "minimal",
+			configuration: Configuration{WebhookUrl: "https://example.com/bot123:abc"},
+			want: axon.O{
+				"url":          "https://example.com/bot123:abc",
+				"secret_token": "s3cr3t",
+			},
+		},
+		{
+			name: "all parameters",
+			configuration: Configuration{
+				WebhookUrl:                "https://example.com/bot123:abc",
+				WebhookIPAddress:          "203.0.113.7",
+				WebhookMaxConnections:     10,
+				AllowedUpdates:            []string{"message"},
+				WebhookDropPendingUpdates: true,
+			},
+			certificate: []byte("PEM"),
+			want: axon.O{
+				"url":                  "https://example.com/bot123:abc",
+				"secret_token":         "s3cr3t",
+				"certificate":          UploadFile{FileName: "certificate.pem", Data: []byte("PEM")},
+				"ip_address":           "203.0.113.7",
+				"max_connections":      int64(10),
+				"allowed_updates":      []string{"message"},
+				"drop_pending_updates": true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := webhookRequest(tt.configuration, "s3cr3t", tt.certificate); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("webhookRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -12,9 +12,14 @@ type Handler func(context.Context, *Bot, axon.O) (bool, error)
 // Matcher is a function that will decide wheter an update will be handled by a Matcher
 type Matcher func(*Bot, axon.O) bool
 
+// ErrorHandler is a function that is notified of the errors that can't be returned to a caller,
+// such as the ones raised by update sources or by handlers.
+type ErrorHandler func(context.Context, *Bot, error)
+
 // UpdatesSource are function that will get updates from the API server or any other source
 // and publish them onto a channel.
-// A proper UpdateSource will handle the context argument as needed.
+// A proper UpdateSource will handle the context argument as needed
+// and report errors through the bot ErrorHandler.
 type UpdatesSource func(*Bot, context.Context, chan axon.O)
 
 // User struct stores user infos for the bot user
@@ -30,6 +35,15 @@ type User struct {
 	SupportsInlineQueries   bool   `json:"supports_inline_queries,omitempty"`
 }
 
+// MessageResult is the result of the methods that return the edited Message,
+// or just True when the edited message was sent via the bot (inline messages).
+type MessageResult struct {
+	// Message is the edited message, nil for inline messages
+	Message axon.O
+	// Inline is true when the API returned True instead of a Message
+	Inline bool
+}
+
 type UploadFile struct {
 	FileName string
 	Data     []byte
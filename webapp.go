@@ -0,0 +1,89 @@
+package ubot
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sdurz/axon"
+)
+
+var (
+	errWebAppHashMismatch = errors.New("web app init data: invalid hash")
+	errWebAppExpired      = errors.New("web app init data: expired")
+)
+
+// ValidateWebAppInitData checks initData, the Web App init data as received by the Mini App,
+// against the token of the bot that launched it and returns the user who opened the Web App.
+// Init data older than maxAge is rejected, a zero maxAge disables the check.
+// see https://core.telegram.org/bots/webapps#validating-data-received-via-the-mini-app
+func ValidateWebAppInitData(initData, token string, maxAge time.Duration) (user axon.O, err error) {
+	return validateWebAppInitData(initData, token, maxAge, time.Now())
+}
+
+// ValidateWebAppInitData validates initData with the bot's APIToken, see ValidateWebAppInitData.
+func (b *Bot) ValidateWebAppInitData(initData string, maxAge time.Duration) (user axon.O, err error) {
+	return ValidateWebAppInitData(initData, b.Configuration.APIToken, maxAge)
+}
+
+func validateWebAppInitData(initData, token string, maxAge time.Duration, now time.Time) (user axon.O, err error) {
+	var values url.Values
+	if values, err = url.ParseQuery(initData); err != nil {
+		err = fmt.Errorf("web app init data: %w", err)
+		return
+	}
+	var gotHash []byte
+	if gotHash, err = hex.DecodeString(values.Get("hash")); err != nil || len(gotHash) == 0 {
+		err = errWebAppHashMismatch
+		return
+	}
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		if key != "hash" {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	pairs := make([]string, len(keys))
+	for i, key := range keys {
+		pairs[i] = key + "=" + values.Get(key)
+	}
+
+	secret := hmac.New(sha256.New, []byte("WebAppData"))
+	secret.Write([]byte(token))
+	mac := hmac.New(sha256.New, secret.Sum(nil))
+	mac.Write([]byte(strings.Join(pairs, "\n")))
+	if !hmac.Equal(mac.Sum(nil), gotHash) {
+		err = errWebAppHashMismatch
+		return
+	}
+
+	var authDate int64
+	if authDate, err = strconv.ParseInt(values.Get("auth_date"), 10, 64); err != nil {
+		err = fmt.Errorf("web app init data: invalid auth_date %q", values.Get("auth_date"))
+		return
+	}
+	if maxAge > 0 && now.Sub(time.Unix(authDate, 0)) > maxAge {
+		err = errWebAppExpired
+		return
+	}
+
+	rawUser := values.Get("user")
+	if rawUser == "" {
+		err = errors.New("web app init data: no user")
+		return
+	}
+	if err = json.Unmarshal([]byte(rawUser), &user); err != nil {
+		err = fmt.Errorf("web app init data: invalid user: %w", err)
+	}
+	return
+}
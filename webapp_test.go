@@ -0,0 +1,85 @@
+package ubot
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sdurz/axon"
+)
+
+const (
+	webAppTestToken    = "123456:TEST-token"
+	webAppTestInitData = "auth_date=1700000000&query_id=AAH&user=%7B%22id%22%3A42%2C%22first_name%22%3A%22Ann%22%2C%22username%22%3A%22ann%22%7D&hash=cd4c0273bbd059694aa484a05984d200e4b00caaf7b3acef9dc9791e6b850c3b"
+)
+
+func Test_validateWebAppInitData(t *testing.T) {
+	signedAt := time.Unix(1700000000, 0)
+	tests := []struct {
+		name     string
+		initData string
+		token    string
+		maxAge   time.Duration
+		now      time.Time
+		wantUser axon.O
+		wantErr  bool
+	}{
+		{
+			name:     "valid",
+			initData: webAppTestInitData,
+			token:    webAppTestToken,
+			maxAge:   time.Hour,
+			now:      signedAt.Add(time.Minute),
+			wantUser: axon.O{"id": 42., "first_name": "Ann", "username": "ann"},
+		},
+		{
+			name:     "no freshness check",
+			initData: webAppTestInitData,
+			token:    webAppTestToken,
+			now:      signedAt.Add(24 * time.Hour),
+			wantUser: axon.O{"id": 42., "first_name": "Ann", "username": "ann"},
+		},
+		{
+			name:     "expired",
+			initData: webAppTestInitData,
+			token:    webAppTestToken,
+			maxAge:   time.Hour,
+			now:      signedAt.Add(2 * time.Hour),
+			wantErr:  true,
+		},
+		{
+			name:     "wrong token",
+			initData: webAppTestInitData,
+			token:    "654321:OTHER-token",
+			now:      signedAt,
+			wantErr:  true,
+		},
+		{
+			name:     "tampered data",
+			initData: strings.Replace(webAppTestInitData, "query_id=AAH", "query_id=AAI", 1),
+			token:    webAppTestToken,
+			now:      signedAt,
+			wantErr:  true,
+		},
+		{
+			name:     "missing hash",
+			initData: "auth_date=1700000000&query_id=AAH",
+			token:    webAppTestToken,
+			now:      signedAt,
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotUser, err := validateWebAppInitData(tt.initData, tt.token, tt.maxAge, tt.now)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateWebAppInitData() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(gotUser, tt.wantUser) {
+				t.Errorf("validateWebAppInitData() = %v, want %v", gotUser, tt.wantUser)
+			}
+		})
+	}
+}
@@ -0,0 +1,288 @@
+package ubot
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/sdurz/axon"
+)
+
+// secretTokenHeader is the header carrying the secret_token set with setWebhook
+const secretTokenHeader = "X-Telegram-Bot-Api-Secret-Token"
+
+// TelegramSubnets are the subnets webhook requests are sent from.
+// see https://core.telegram.org/bots/webhooks#the-short-version
+var TelegramSubnets = []string{"149.154.160.0/20", "91.108.4.0/22"}
+
+// newSecretToken returns a random secret_token for setWebhook
+func newSecretToken() (string, error) {
+	token := make([]byte, 32)
+	if _, err := rand.Read(token); err != nil {
+		return "", fmt.Errorf("can't generate a secret token: %w", err)
+	}
+	return hex.EncodeToString(token), nil
+}
+
+// validSecretToken tells whether r carries secretToken, in constant time.
+// No request is valid for an empty secretToken.
+func validSecretToken(r *http.Request, secretToken string) bool {
+	return secretToken != "" && subtle.ConstantTimeCompare([]byte(r.Header.Get(secretTokenHeader)), []byte(secretToken)) == 1
+}
+
+// ipFilter accepts the requests sent from a set of subnets
+type ipFilter struct {
+	allowed        []*net.IPNet
+	trustedProxies []*net.IPNet
+}
+
+// newIPFilter returns an ipFilter for the allowed CIDRs. Requests forwarded by trustedProxies
+// are filtered on the client address they add to X-Forwarded-For.
+func newIPFilter(allowed, trustedProxies []string) (filter *ipFilter, err error) {
+	filter = &ipFilter{}
+	if filter.allowed, err = parseCIDRs(allowed); err != nil {
+		return
+	}
+	filter.trustedProxies, err = parseCIDRs(trustedProxies)
+	return
+}
+
+func parseCIDRs(cidrs []string) (result []*net.IPNet, err error) {
+	for _, cidr := range cidrs {
+		var ipNet *net.IPNet
+		if _, ipNet, err = net.ParseCIDR(cidr); err != nil {
+			return
+		}
+		result = append(result, ipNet)
+	}
+	return
+}
+
+func containsIP(ipNets []*net.IPNet, ip net.IP) bool {
+	for _, ipNet := range ipNets {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// clientIP returns the address r was sent from. X-Forwarded-For is only read when the
+// request comes from a trusted proxy, the client is its right-most untrusted entry.
+func (f *ipFilter) clientIP(r *http.Request) net.IP {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !containsIP(f.trustedProxies, ip) {
+		return ip
+	}
+
+	var forwarded []string
+	for _, header := range r.Header.Values("X-Forwarded-For") {
+		forwarded = append(forwarded, strings.Split(header, ",")...)
+	}
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		if ip = net.ParseIP(strings.TrimSpace(forwarded[i])); ip == nil || !containsIP(f.trustedProxies, ip) {
+			return ip
+		}
+	}
+	return ip
+}
+
+// allows tells whether r was sent from an allowed subnet
+func (f *ipFilter) allows(r *http.Request) bool {
+	ip := f.clientIP(r)
+	return ip != nil && containsIP(f.allowed, ip)
+}
+
+// WebhookHandler is an http.Handler that feeds the updates posted to the webhook to a Bot.
+// It can be mounted on any http server or router, the webhook must be set with SetWebhook.
+// Forever is not needed to serve it: since the bot user isn't retrieved, set BotUser
+// from GetMe if matchers such as MessageHasCommand need it.
+type WebhookHandler struct {
+	// SecretToken is the secret_token to set with setWebhook,
+	// requests that don't carry it are answered with 403 Forbidden.
+	SecretToken string
+	// Reply allows handlers to answer the update in the webhook response, see WebhookReply.
+	// It has no effect in Async mode.
+	Reply bool
+	// Async acknowledges updates as soon as they're queued to the bot worker pool, shared with
+	// Forever and bounded by WorkerNo, so that slow handlers don't hold the webhook connections.
+	// While all the workers are busy the acknowledgement waits for a free one.
+	Async bool
+	// RetryOnError answers 500 Internal Server Error when a handler fails with an error marked
+	// with Transient, so that the API server delivers the update again. Other failures, as well as
+	// malformed or unknown updates, are acknowledged. It has no effect in Async mode.
+	RetryOnError bool
+
+	ctx    context.Context
+	bot    *Bot
+	filter *ipFilter
+}
+
+// NewWebhookHandler returns a WebhookHandler that processes updates with bot within ctx.
+// Its options are initialized from the bot Configuration: WebhookSecretToken, WebhookReply,
+// WebhookAsync, WebhookRetryOnError, and WebhookCheckIP with TrustedProxies.
+// If WebhookSecretToken is empty a random SecretToken is generated.
+func NewWebhookHandler(ctx context.Context, bot *Bot) (handler *WebhookHandler, err error) {
+	handler = &WebhookHandler{
+		SecretToken:  bot.Configuration.WebhookSecretToken,
+		Reply:        bot.Configuration.WebhookReply,
+		Async:        bot.Configuration.WebhookAsync,
+		RetryOnError: bot.Configuration.WebhookRetryOnError,
+		ctx:          ctx,
+		bot:          bot,
+	}
+	if handler.SecretToken == "" {
+		if handler.SecretToken, err = newSecretToken(); err != nil {
+			return
+		}
+	}
+	if bot.Configuration.WebhookCheckIP {
+		if handler.filter, err = newIPFilter(TelegramSubnets, bot.Configuration.TrustedProxies); err != nil {
+			err = fmt.Errorf("invalid trusted proxies: %w", err)
+		}
+	}
+	return
+}
+
+// ServeHTTP implements http.Handler
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var (
+		body      []byte
+		rawUpdate interface{}
+		update    axon.O
+		err       error
+		ok        bool
+	)
+	bot := h.bot
+
+	if h.filter != nil && !h.filter.allows(r) {
+		bot.log().Log(LevelWarn, "webhook request from a forbidden address", "remote_addr", r.RemoteAddr)
+		http.Error(w, "forbidden", http.StatusForbidden)
+		return
+	}
+	if !validSecretToken(r, h.SecretToken) {
+		bot.log().Log(LevelWarn, "webhook request with an invalid secret token", "remote_addr", r.RemoteAddr)
+		http.Error(w, "forbidden", http.StatusForbidden)
+		return
+	}
+
+	body, err = ioutil.ReadAll(r.Body)
+	if err != nil {
+		bot.log().Log(LevelWarn, "error reading body", "error", err)
+		http.Error(w, "can't read body", http.StatusBadRequest)
+		return
+	}
+	bot.log().Log(LevelDebug, "got update", "body", string(body))
+	if err = json.Unmarshal(body, &rawUpdate); err != nil {
+		bot.log().Log(LevelWarn, "error decoding body", "error", err)
+		http.Error(w, "can't decode body", http.StatusBadRequest)
+		return
+	}
+	if update, ok = rawUpdate.(map[string]interface{}); !ok {
+		bot.log().Log(LevelWarn, "error decoding body", "error", "update not an axon.O")
+		return
+	}
+
+	if h.Async {
+		if err = bot.dispatch(h.ctx, r.Context(), update); err != nil {
+			bot.log().Log(LevelWarn, "no free worker for the update", "error", err)
+			http.Error(w, "busy", http.StatusServiceUnavailable)
+		}
+		return
+	}
+
+	ctx := h.ctx
+	var reply *webhookReply
+	if h.Reply {
+		reply = &webhookReply{}
+		ctx = context.WithValue(ctx, webhookReplyKey{}, reply)
+	}
+	if err = bot.process(ctx, update); err != nil {
+		bot.reportError(ctx, fmt.Errorf("update processing error: %w", err))
+		if h.RetryOnError && IsTransient(err) {
+			http.Error(w, "update processing error", http.StatusInternalServerError)
+			return
+		}
+	}
+	if reply != nil && reply.request != nil {
+		bot.log().Log(LevelDebug, "replying in webhook", "method", reply.request["method"])
+		w.Header().Set("Content-Type", "application/json")
+		if err = json.NewEncoder(w).Encode(reply.request); err != nil {
+			bot.log().Log(LevelWarn, "error writing webhook reply", "error", err)
+		}
+	}
+}
+
+// transientError marks an error as a temporary failure
+type transientError struct {
+	err error
+}
+
+func (t *transientError) Error() string {
+	return t.err.Error()
+}
+
+func (t *transientError) Unwrap() error {
+	return t.err
+}
+
+// Transient marks err as a temporary failure, such as an unavailable database: returned by
+// a handler, it makes a WebhookHandler with RetryOnError ask for the update to be delivered again.
+// Transient(nil) is nil.
+func Transient(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &transientError{err: err}
+}
+
+// IsTransient tells whether err, or an error it wraps, has been marked with Transient.
+func IsTransient(err error) bool {
+	var transient *transientError
+	return errors.As(err, &transient)
+}
+
+// webhookReplyKey is the context key of the webhookReply of the update being processed
+type webhookReplyKey struct{}
+
+// webhookReply holds the request sent in the webhook response
+type webhookReply struct {
+	mutex   sync.Mutex
+	request axon.O
+}
+
+// WebhookReply sends the API request method in the response to the webhook request
+// of the update being processed, saving an outgoing request. The result of the method
+// isn't available and files can't be uploaded this way.
+// It's possible only once per update, with a WebhookHandler that allows it: when it returns
+// false the request must be sent as usual.
+// see https://core.telegram.org/bots/faq#how-can-i-make-requests-in-response-to-updates
+func WebhookReply(ctx context.Context, method string, request axon.O) bool {
+	reply, ok := ctx.Value(webhookReplyKey{}).(*webhookReply)
+	if !ok {
+		return false
+	}
+	reply.mutex.Lock()
+	defer reply.mutex.Unlock()
+	if reply.request != nil {
+		return false
+	}
+	reply.request = axon.O{"method": method}
+	for key, value := range request {
+		reply.request[key] = value
+	}
+	return true
+}
@@ -0,0 +1,462 @@
+package ubot
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sdurz/axon"
+)
+
+func Test_ipFilter_allows(t *testing.T) {
+	tests := []struct {
+		name           string
+		trustedProxies []string
+		remoteAddr     string
+		forwardedFor   []string
+		want           bool
+	}{
+		{
+			name:       "telegram address",
+			remoteAddr: "149.154.167.99:443",
+			want:       true,
+		},
+		{
+			name:       "other address",
+			remoteAddr: "203.0.113.7:443",
+			want:       false,
+		},
+		{
+			name:         "forwarded by an untrusted proxy",
+			remoteAddr:   "10.0.0.2:8080",
+			forwardedFor: []string{"149.154.167.99"},
+			want:         false,
+		},
+		{
+			name:           "forwarded by a trusted proxy",
+			trustedProxies: []string{"10.0.0.0/8"},
+			remoteAddr:     "10.0.0.2:8080",
+			forwardedFor:   []string{"149.154.167.99"},
+			want:           true,
+		},
+		{
+			name:           "spoofed X-Forwarded-For",
+			trustedProxies: []string{"10.0.0.0/8"},
+			remoteAddr:     "10.0.0.2:8080",
+			forwardedFor:   []string{"149.154.167.99, 203.0.113.7"},
+			want:           false,
+		},
+		{
+			name:           "proxy chain",
+			trustedProxies: []string{"10.0.0.0/8"},
+			remoteAddr:     "10.0.0.2:8080",
+			forwardedFor:   []string{"203.0.113.7, 91.108.4.10", "10.0.0.3"},
+			want:           true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := newIPFilter(TelegramSubnets, tt.trustedProxies)
+			if err != nil {
+				t.Fatalf("newIPFilter() error = %v", err)
+			}
+			r := httptest.NewRequest(http.MethodPost, "/bot123:abc", nil)
+			r.RemoteAddr = tt.remoteAddr
+			for _, value := range tt.forwardedFor {
+				r.Header.Add("X-Forwarded-For", value)
+			}
+			if got := filter.allows(r); got != tt.want {
+				t.Errorf("ipFilter.allows() = %v, want %v (client %v)", got, tt.want, filter.clientIP(r))
+			}
+		})
+	}
+}
+
+func Test_newIPFilter_invalid(t *testing.T) {
+	if _, err := newIPFilter(TelegramSubnets, []string{"10.0.0.1"}); err == nil {
+		t.Errorf("newIPFilter() accepted an address without mask")
+	}
+}
+
+func Test_newSecretToken(t *testing.T) {
+	first, err := newSecretToken()
+	if err != nil {
+		t.Fatalf("newSecretToken() error = %v", err)
+	}
+	second, _ := newSecretToken()
+	if len(first) != 64 || first == second {
+		t.Errorf("newSecretToken() = %v, %v, want distinct 64 chars tokens", first, second)
+	}
+}
+
+func TestWebhookHandler_ServeHTTP(t *testing.T) {
+	tests := []struct {
+		name            string
+		secretToken     string
+		checkIP         bool
+		remoteAddr      string
+		header          string
+		body            string
+		wantStatus      int
+		wantInvocations int
+	}{
+		{
+			name:            "valid request",
+			secretToken:     "s3cr3t",
+			checkIP:         true,
+			remoteAddr:      "149.154.167.99:443",
+			header:          "s3cr3t",
+			body:            `{"update_id": 1, "message": {"text": "hello"}}`,
+			wantStatus:      http.StatusOK,
+			wantInvocations: 1,
+		},
+		{
+			name:       "no secret token configured",
+			remoteAddr: "149.154.167.99:443",
+			body:       `{"update_id": 1, "message": {"text": "hello"}}`,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:        "missing secret token",
+			secretToken: "s3cr3t",
+			remoteAddr:  "149.154.167.99:443",
+			body:        `{"update_id": 1, "message": {"text": "hello"}}`,
+			wantStatus:  http.StatusForbidden,
+		},
+		{
+			name:        "wrong secret token",
+			secretToken: "s3cr3t",
+			remoteAddr:  "149.154.167.99:443",
+			header:      "guess",
+			body:        `{"update_id": 1, "message": {"text": "hello"}}`,
+			wantStatus:  http.StatusForbidden,
+		},
+		{
+			name:        "forbidden address",
+			secretToken: "s3cr3t",
+			checkIP:     true,
+			remoteAddr:  "203.0.113.7:443",
+			header:      "s3cr3t",
+			body:        `{"update_id": 1, "message": {"text": "hello"}}`,
+			wantStatus:  http.StatusForbidden,
+		},
+		{
+			name:        "malformed update",
+			secretToken: "s3cr3t",
+			remoteAddr:  "149.154.167.99:443",
+			header:      "s3cr3t",
+			body:        `{"update_id": `,
+			wantStatus:  http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			invocations := 0
+			b := &Bot{}
+			b.AddMessageHandler(Always, func(ctx context.Context, bot *Bot, message axon.O) (bool, error) {
+				invocations++
+				return true, nil
+			})
+			var filter *ipFilter
+			if tt.checkIP {
+				filter, _ = newIPFilter(TelegramSubnets, nil)
+			}
+
+			r := httptest.NewRequest(http.MethodPost, "/bot123:abc", strings.NewReader(tt.body))
+			r.RemoteAddr = tt.remoteAddr
+			if tt.header != "" {
+				r.Header.Set("X-Telegram-Bot-Api-Secret-Token", tt.header)
+			}
+			w := httptest.NewRecorder()
+			handler := &WebhookHandler{SecretToken: tt.secretToken, ctx: context.Background(), bot: b, filter: filter}
+			handler.ServeHTTP(w, r)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("WebhookHandler.ServeHTTP() status = %v, want %v", w.Code, tt.wantStatus)
+			}
+			if invocations != tt.wantInvocations {
+				t.Errorf("WebhookHandler.ServeHTTP() invocations = %v, want %v", invocations, tt.wantInvocations)
+			}
+		})
+	}
+}
+
+// newTestUpdateRequest returns a webhook request for body, with the s3cr3t secret token
+func newTestUpdateRequest(body string) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, "/bot123:abc", strings.NewReader(body))
+	r.Header.Set("X-Telegram-Bot-Api-Secret-Token", "s3cr3t")
+	return r
+}
+
+func TestWebhookReply(t *testing.T) {
+	tests := []struct {
+		name     string
+		reply    bool
+		replies  int
+		wantOk   bool
+		wantBody axon.O
+	}{
+		{
+			name:    "reply in webhook",
+			reply:   true,
+			replies: 1,
+			wantOk:  true,
+			wantBody: axon.O{
+				"method":  "sendMessage",
+				"chat_id": 1.,
+				"text":    "hi",
+			},
+		},
+		{
+			name:    "second reply",
+			reply:   true,
+			replies: 2,
+			wantOk:  false,
+			wantBody: axon.O{
+				"method":  "sendMessage",
+				"chat_id": 1.,
+				"text":    "hi",
+			},
+		},
+		{
+			name:    "reply not allowed",
+			reply:   false,
+			replies: 1,
+			wantOk:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotOk bool
+			b := &Bot{}
+			b.AddMessageHandler(Always, func(ctx context.Context, bot *Bot, message axon.O) (bool, error) {
+				for i := 0; i < tt.replies; i++ {
+					gotOk = WebhookReply(ctx, "sendMessage", axon.O{"chat_id": 1., "text": "hi"})
+				}
+				return true, nil
+			})
+
+			r := newTestUpdateRequest(`{"update_id": 1, "message": {"text": "hello"}}`)
+			w := httptest.NewRecorder()
+			handler := &WebhookHandler{SecretToken: "s3cr3t", Reply: tt.reply, ctx: context.Background(), bot: b}
+			handler.ServeHTTP(w, r)
+
+			if gotOk != tt.wantOk {
+				t.Errorf("WebhookReply() = %v, want %v", gotOk, tt.wantOk)
+			}
+			var gotBody axon.O
+			if w.Body.Len() > 0 {
+				if err := json.Unmarshal(w.Body.Bytes(), &gotBody); err != nil {
+					t.Fatalf("WebhookHandler.ServeHTTP() body = %v", w.Body.String())
+				}
+			}
+			if !reflect.DeepEqual(gotBody, tt.wantBody) {
+				t.Errorf("WebhookHandler.ServeHTTP() body = %v, want %v", gotBody, tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestWebhookReply_outsideWebhook(t *testing.T) {
+	if WebhookReply(context.Background(), "sendMessage", axon.O{"chat_id": 1.}) {
+		t.Errorf("WebhookReply() = true outside of a webhook request")
+	}
+}
+
+func TestNewWebhookHandler(t *testing.T) {
+	tests := []struct {
+		name          string
+		configuration Configuration
+		wantHandler   *WebhookHandler
+		wantFilter    bool
+		wantErr       bool
+	}{
+		{
+			name: "from configuration",
+			configuration: Configuration{
+				WebhookSecretToken: "s3cr3t",
+				WebhookReply:       true,
+				WebhookCheckIP:     true,
+			},
+			wantHandler: &WebhookHandler{SecretToken: "s3cr3t", Reply: true},
+			wantFilter:  true,
+		},
+		{
+			name:          "defaults",
+			configuration: Configuration{},
+			wantHandler:   &WebhookHandler{},
+		},
+		{
+			name: "invalid trusted proxies",
+			configuration: Configuration{
+				WebhookCheckIP: true,
+				TrustedProxies: []string{"proxy.local"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{Configuration: tt.configuration}
+			got, err := NewWebhookHandler(context.Background(), b)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewWebhookHandler() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if tt.wantHandler.SecretToken == "" && len(got.SecretToken) != 64 {
+				t.Errorf("NewWebhookHandler() SecretToken = %q, want a generated one", got.SecretToken)
+			} else if tt.wantHandler.SecretToken != "" && got.SecretToken != tt.wantHandler.SecretToken {
+				t.Errorf("NewWebhookHandler() SecretToken = %q, want %q", got.SecretToken, tt.wantHandler.SecretToken)
+			}
+			if got.Reply != tt.wantHandler.Reply || got.bot != b {
+				t.Errorf("NewWebhookHandler() = %+v, want %+v", got, tt.wantHandler)
+			}
+			if (got.filter != nil) != tt.wantFilter {
+				t.Errorf("NewWebhookHandler() filter = %v, want %v", got.filter, tt.wantFilter)
+			}
+		})
+	}
+}
+
+func TestWebhookHandler_RetryOnError(t *testing.T) {
+	tests := []struct {
+		name         string
+		retryOnError bool
+		handlerErr   error
+		body         string
+		wantStatus   int
+	}{
+		{
+			name:       "failure acknowledged",
+			handlerErr: errors.New("database down"),
+			body:       `{"update_id": 1, "message": {"text": "hello"}}`,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:         "transient failure redelivered",
+			retryOnError: true,
+			handlerErr:   Transient(errors.New("database down")),
+			body:         `{"update_id": 1, "message": {"text": "hello"}}`,
+			wantStatus:   http.StatusInternalServerError,
+		},
+		{
+			name:         "wrapped transient failure redelivered",
+			retryOnError: true,
+			handlerErr:   fmt.Errorf("saving message: %w", Transient(errors.New("database down"))),
+			body:         `{"update_id": 1, "message": {"text": "hello"}}`,
+			wantStatus:   http.StatusInternalServerError,
+		},
+		{
+			name:         "permanent failure acknowledged",
+			retryOnError: true,
+			handlerErr:   errors.New("invalid command"),
+			body:         `{"update_id": 1, "message": {"text": "hello"}}`,
+			wantStatus:   http.StatusOK,
+		},
+		{
+			name:         "unknown update type",
+			retryOnError: true,
+			body:         `{"update_id": 1, "new_kind_of_update": {"id": 1}}`,
+			wantStatus:   http.StatusOK,
+		},
+		{
+			name:         "payload not an object",
+			retryOnError: true,
+			body:         `{"update_id": 1, "message": "hello"}`,
+			wantStatus:   http.StatusOK,
+		},
+		{
+			name:         "success",
+			retryOnError: true,
+			body:         `{"update_id": 1, "message": {"text": "hello"}}`,
+			wantStatus:   http.StatusOK,
+		},
+		{
+			name:         "malformed update",
+			retryOnError: true,
+			body:         `{"update_id": `,
+			wantStatus:   http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bot{}
+			b.SetErrorHandler(func(ctx context.Context, bot *Bot, err error) {})
+			b.AddMessageHandler(Always, func(ctx context.Context, bot *Bot, message axon.O) (bool, error) {
+				return true, tt.handlerErr
+			})
+
+			r := newTestUpdateRequest(tt.body)
+			w := httptest.NewRecorder()
+			handler := &WebhookHandler{SecretToken: "s3cr3t", RetryOnError: tt.retryOnError, ctx: context.Background(), bot: b}
+			handler.ServeHTTP(w, r)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("WebhookHandler.ServeHTTP() status = %v, want %v", w.Code, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestWebhookHandler_Async(t *testing.T) {
+	release := make(chan struct{})
+	handled := make(chan struct{}, 2)
+	b := &Bot{Configuration: Configuration{WorkerNo: 1}}
+	b.AddMessageHandler(Always, func(ctx context.Context, bot *Bot, message axon.O) (bool, error) {
+		<-release
+		handled <- struct{}{}
+		return true, nil
+	})
+	handler := &WebhookHandler{SecretToken: "s3cr3t", Async: true, ctx: context.Background(), bot: b}
+	body := `{"update_id": 1, "message": {"text": "hello"}}`
+
+	// the update is acknowledged while its handler is still running
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, newTestUpdateRequest(body))
+	if w.Code != http.StatusOK {
+		t.Errorf("WebhookHandler.ServeHTTP() status = %v, want %v", w.Code, http.StatusOK)
+	}
+
+	// the only worker is busy: the request gives up waiting
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, newTestUpdateRequest(body).WithContext(ctx))
+	if w.Code != http.StatusServiceUnavailable {
+		t.Errorf("WebhookHandler.ServeHTTP() status = %v, want %v", w.Code, http.StatusServiceUnavailable)
+	}
+
+	close(release)
+	select {
+	case <-handled:
+	case <-time.After(time.Second):
+		t.Fatalf("WebhookHandler.ServeHTTP() update not handled")
+	}
+	select {
+	case <-handled:
+		t.Errorf("WebhookHandler.ServeHTTP() handled a rejected update")
+	case <-time.After(20 * time.Millisecond):
+	}
+}
+
+func TestTransient(t *testing.T) {
+	if Transient(nil) != nil {
+		t.Errorf("Transient(nil) should be nil")
+	}
+	err := errors.New("database down")
+	if got := Transient(err); !IsTransient(got) || !errors.Is(got, err) || got.Error() != err.Error() {
+		t.Errorf("Transient() = %v, should wrap %v", got, err)
+	}
+	if IsTransient(err) {
+		t.Errorf("IsTransient() = true for an unmarked error")
+	}
+}